@@ -2,6 +2,7 @@ package core
 
 import (
 	"context"
+	"os"
 	"time"
 
 	assert "github.com/Rafael24595/go-assert/assert/runtime"
@@ -13,10 +14,14 @@ import (
 	"github.com/Rafael24595/go-reacterm-core/engine/app/state"
 	"github.com/Rafael24595/go-reacterm-core/engine/app/viewmodel"
 	"github.com/Rafael24595/go-reacterm-core/engine/layout"
+	"github.com/Rafael24595/go-reacterm-core/engine/model/hit"
 	"github.com/Rafael24595/go-reacterm-core/engine/model/key"
+	"github.com/Rafael24595/go-reacterm-core/engine/model/mouse"
 	"github.com/Rafael24595/go-reacterm-core/engine/model/pulse"
 	"github.com/Rafael24595/go-reacterm-core/engine/model/winsize"
 	"github.com/Rafael24595/go-reacterm-core/engine/render"
+	"github.com/Rafael24595/go-reacterm-core/engine/render/styler"
+	"github.com/Rafael24595/go-reacterm-core/engine/render/text"
 	"github.com/Rafael24595/go-reacterm-core/engine/terminal"
 )
 
@@ -31,6 +36,16 @@ type Engine struct {
 	cleaner  cleaner.StateCleaner
 	node     screen.Node
 	passes   []screen.Pass
+	frame    []text.Line
+	framed   winsize.Winsize
+	origin   winsize.Winsize
+	dump     *dump
+}
+
+// dump exports the frame on screen through an alternative processor.
+type dump struct {
+	processor render.Processor
+	path      func(time.Time) string
 }
 
 // TODO: Disable pulse on proactive terminal
@@ -52,6 +67,10 @@ func NewEngine(
 		cleaner:  cleaner,
 		node:     node,
 		passes:   make([]screen.Pass, 0),
+		frame:    make([]text.Line, 0),
+		framed:   winsize.Winsize{},
+		origin:   winsize.Winsize{},
+		dump:     nil,
 	}
 }
 
@@ -75,6 +94,21 @@ func (e *Engine) AddPass(passes ...screen.Pass) *Engine {
 	return e
 }
 
+// Dump writes the frame on screen through processor to the file named by
+// path each time the dump action is pressed.
+func (e *Engine) Dump(processor render.Processor, path func(time.Time) string) *Engine {
+	if e.running {
+		assert.Unreachable("the engine can be modified after initialization")
+		return e
+	}
+
+	e.dump = &dump{
+		processor: processor,
+		path:      path,
+	}
+	return e
+}
+
 func (e *Engine) Run() <-chan struct{} {
 	return e.RunWithContext(
 		context.Background(),
@@ -114,12 +148,16 @@ func (e *Engine) run() {
 	}
 
 	uiState := state.NewUIState()
+	uiState.Size = size
 
 	e.compileNodeScreen(*uiState, e.node)
 	e.renderFrame(uiState, size)
 
 	keys := e.terminal.KeyEvents()
+	mice := e.mouseEvents()
+	pastes := e.pasteEvents()
 	resizes := e.terminal.ResizeEvents()
+	resumes := e.resumeEvents()
 
 	for {
 		select {
@@ -134,7 +172,34 @@ func (e *Engine) run() {
 				return
 			}
 
-			e.tickNode(uiState, size, k)
+			if k.Code == key.ActionSuspend {
+				e.suspend()
+				continue
+			}
+
+			if k.Code == key.CustomActionDump && e.dump != nil {
+				e.dumpFrame(size)
+				continue
+			}
+
+			e.tickNode(uiState, size, screen.NewEvent(k))
+
+		case m, ok := <-mice:
+			if !ok {
+				mice = nil
+				continue
+			}
+
+			event := screen.NewMouseEvent(m, e.resolveHit(m))
+			e.tickNode(uiState, size, event)
+
+		case p, ok := <-pastes:
+			if !ok {
+				pastes = nil
+				continue
+			}
+
+			e.tickNode(uiState, size, screen.NewPasteEvent(p))
 
 		case s, ok := <-resizes:
 			if !ok {
@@ -144,6 +209,15 @@ func (e *Engine) run() {
 			size = s
 			e.renderFrame(uiState, size)
 
+		case s, ok := <-resumes:
+			if !ok {
+				resumes = nil
+				continue
+			}
+
+			size = s
+			e.renderFrame(uiState, size)
+
 		case <-e.doneSgnl:
 			return
 		}
@@ -170,14 +244,77 @@ func (e *Engine) compileNodeScreen(uiState state.UIState, node screen.Node) *Eng
 	return e
 }
 
+func (e *Engine) mouseEvents() <-chan mouse.Mouse {
+	if e.terminal.MouseEvents == nil {
+		return nil
+	}
+	return e.terminal.MouseEvents()
+}
+
+func (e *Engine) pasteEvents() <-chan string {
+	if e.terminal.PasteEvents == nil {
+		return nil
+	}
+	return e.terminal.PasteEvents()
+}
+
+func (e *Engine) resumeEvents() <-chan winsize.Winsize {
+	if e.terminal.ResumeEvents == nil {
+		return nil
+	}
+	return e.terminal.ResumeEvents()
+}
+
+func (e *Engine) suspend() {
+	if e.terminal.Suspend == nil {
+		return
+	}
+
+	err := e.terminal.Suspend()
+	if err != nil {
+		e.logErr(err)
+	}
+}
+
+// dumpFrame only logs its errors, a failed export must not stop the app.
+func (e *Engine) dumpFrame(size winsize.Winsize) {
+	content := e.dump.processor(e.frame, size)
+	path := e.dump.path(time.Now())
+
+	if err := os.WriteFile(path, []byte(content), 0o644); err != nil {
+		log.Error(err)
+		return
+	}
+
+	log.Messagef("frame dumped to %s", path)
+}
+
+// resolveHit finds the target under the pointer, first by row and then
+// by the column of the fragment it lands on. The row is laid out at the
+// size the frame was composed at, so centred lines keep their padding.
+func (e *Engine) resolveHit(m mouse.Mouse) *hit.Target {
+	if m.Row < e.origin.Rows || m.Col < e.origin.Cols {
+		return nil
+	}
+
+	row := int(m.Row - e.origin.Rows)
+	if row >= len(e.frame) {
+		return nil
+	}
+
+	line := styler.NewDefaultSpec().
+		Materialize(e.frame[row], e.framed)
+
+	return line.HitAt(m.Col - e.origin.Cols)
+}
+
 func (e *Engine) tickNode(
 	uiState *state.UIState,
 	size winsize.Winsize,
-	key key.Key,
+	event screen.Event,
 ) *state.UIState {
-	result := e.node.Screen.Tick(uiState,
-		screen.NewEvent(key),
-	)
+	uiState.Size = size
+	result := e.node.Screen.Tick(uiState, event)
 
 	e.manageResult(uiState, result)
 	e.manageNode(*uiState, result)
@@ -212,11 +349,22 @@ func (e *Engine) syncPulse(vm viewmodel.ViewModel) viewmodel.ViewModel {
 }
 
 func (e *Engine) renderFrame(uiState *state.UIState, size winsize.Winsize) {
+	uiState.Size = size
 	vm := e.node.Screen.View(*uiState)
 
 	uiState, lines := e.layout.Compose(uiState, vm, size)
 	result := e.render.Processor(lines, size)
 
+	e.frame = lines
+	e.framed = size
+	if e.layout.Size != nil {
+		e.framed = e.layout.Size(size)
+	}
+
+	if e.render.Origin != nil {
+		e.origin = e.render.Origin(size)
+	}
+
 	e.syncPager(uiState, &vm)
 	e.syncPulse(vm)
 
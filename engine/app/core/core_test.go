@@ -0,0 +1,133 @@
+package core
+
+import (
+	"context"
+	"testing"
+
+	assert "github.com/Rafael24595/go-assert/assert/test"
+
+	"github.com/Rafael24595/go-reacterm-core/engine/app/cleaner/stack"
+	"github.com/Rafael24595/go-reacterm-core/engine/app/screen"
+	"github.com/Rafael24595/go-reacterm-core/engine/app/state"
+	"github.com/Rafael24595/go-reacterm-core/engine/app/viewmodel"
+	"github.com/Rafael24595/go-reacterm-core/engine/layout"
+	"github.com/Rafael24595/go-reacterm-core/engine/layout/composer"
+	"github.com/Rafael24595/go-reacterm-core/engine/layout/drawable/spatial/justify"
+	"github.com/Rafael24595/go-reacterm-core/engine/model/hit"
+	"github.com/Rafael24595/go-reacterm-core/engine/model/key"
+	"github.com/Rafael24595/go-reacterm-core/engine/model/mouse"
+	"github.com/Rafael24595/go-reacterm-core/engine/model/winsize"
+	"github.com/Rafael24595/go-reacterm-core/engine/model/winsize/transformer"
+	"github.com/Rafael24595/go-reacterm-core/engine/render"
+	"github.com/Rafael24595/go-reacterm-core/engine/render/processor"
+	"github.com/Rafael24595/go-reacterm-core/engine/render/style"
+	"github.com/Rafael24595/go-reacterm-core/engine/render/text"
+	"github.com/Rafael24595/go-reacterm-core/engine/terminal"
+
+	screen_test "github.com/Rafael24595/go-reacterm-core/test/engine/app/screen"
+)
+
+func mockTerminal(keys <-chan key.Key, closed *int) terminal.Terminal {
+	resizes := make(chan winsize.Winsize)
+	return terminal.Terminal{
+		OnStart: func() error { return nil },
+		OnClose: func() error {
+			*closed += 1
+			return nil
+		},
+		ResizeEvents: func() <-chan winsize.Winsize { return resizes },
+		KeyEvents:    func() <-chan key.Key { return keys },
+		Size:         func() (winsize.Winsize, error) { return winsize.New(10, 40), nil },
+		Clear:        func() error { return nil },
+		Write:        func(...string) error { return nil },
+		WriteAll:     func(string) error { return nil },
+		Flush:        func() error { return nil },
+	}
+}
+
+func mockEngine(terminal terminal.Terminal, node screen.Node, transformers ...winsize.Transformer) *Engine {
+	rnd := render.NewBuilder(
+		func([]text.Line, winsize.Winsize) string { return "" },
+	)
+
+	lyt := layout.NewBuilder(composer.Standard)
+
+	for _, t := range transformers {
+		rnd.Origin(processor.PaddingOrigin(t))
+		lyt.Transformer(t)
+	}
+
+	engine := NewEngine(terminal, lyt.ToLayout(), rnd.ToRender(), stack.NewCleaner(), node)
+	engine.context = context.Background()
+
+	return engine
+}
+
+func TestEngine_RestoresOnPanic(t *testing.T) {
+	keys := make(chan key.Key, 1)
+	keys <- *key.NewKeyCode(key.ActionEnter)
+
+	node := screen_test.MockScreen{
+		Name: "panic",
+		Tick: func(*state.UIState, screen.Event) screen.Result {
+			panic("tick failed")
+		},
+	}.ToNode()
+
+	closed := 0
+	engine := mockEngine(mockTerminal(keys, &closed), node)
+
+	assert.Panic(t, func() {
+		engine.run()
+	})
+
+	assert.Equal(t, 1, closed)
+}
+
+func TestEngine_RestoresOnExit(t *testing.T) {
+	keys := make(chan key.Key, 1)
+	keys <- *key.NewKeyCode(key.ActionExit)
+
+	node := screen_test.MockScreen{Name: "exit"}.ToNode()
+
+	closed := 0
+	engine := mockEngine(mockTerminal(keys, &closed), node)
+
+	engine.run()
+
+	assert.Equal(t, 1, closed)
+}
+
+func TestEngine_ResolveHitWithMargin(t *testing.T) {
+	node := screen_test.MockScreen{
+		Name: "menu",
+		View: func(state.UIState) viewmodel.ViewModel {
+			vm := viewmodel.New()
+			vm.Kernel.Push(
+				justify.New(text.FragmentsFromString("aa", "bb")).
+					Target("menu").
+					Justify(style.JustifyCenter).
+					ToUnit(),
+			)
+			return *vm
+		},
+	}.ToNode()
+
+	closed := 0
+	engine := mockEngine(
+		mockTerminal(nil, &closed), node,
+		transformer.WithMargin(2, 10),
+	)
+
+	engine.compileNodeScreen(*state.NewUIState(), node)
+	engine.renderFrame(state.NewUIState(), winsize.New(10, 40))
+
+	click := func(col winsize.Cols) *hit.Target {
+		return engine.resolveHit(*mouse.New(mouse.KindPress, mouse.ButtonLeft, 1, col))
+	}
+
+	// The line is centred within the 30 inner cols: "aa" at 12 and "bb" at 15.
+	assert.Equal(t, 0, click(5+12).Index)
+	assert.Equal(t, 1, click(5+15).Index)
+	assert.Nil(t, click(4))
+}
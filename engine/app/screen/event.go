@@ -1,13 +1,60 @@
 package screen
 
-import "github.com/Rafael24595/go-reacterm-core/engine/model/key"
+import (
+	"github.com/Rafael24595/go-reacterm-core/engine/model/hit"
+	"github.com/Rafael24595/go-reacterm-core/engine/model/key"
+	"github.com/Rafael24595/go-reacterm-core/engine/model/mouse"
+)
 
 type Event struct {
-	Key key.Key
+	Key   key.Key
+	Mouse *mouse.Mouse
+	Hit   *hit.Target
+	Paste string
 }
 
 func NewEvent(key key.Key) Event {
 	return Event{
-		Key: key,
+		Key:   key,
+		Mouse: nil,
+		Hit:   nil,
+		Paste: "",
 	}
 }
+
+func NewMouseEvent(mouse mouse.Mouse, target *hit.Target) Event {
+	return Event{
+		Key:   mouse.ToKey(),
+		Mouse: &mouse,
+		Hit:   target,
+		Paste: "",
+	}
+}
+
+func NewPasteEvent(text string) Event {
+	return Event{
+		Key:   *key.NewKeyCode(key.ActionPaste),
+		Mouse: nil,
+		Hit:   nil,
+		Paste: text,
+	}
+}
+
+func (e Event) IsMouse() bool {
+	return e.Mouse != nil
+}
+
+func (e Event) IsPaste() bool {
+	return e.Key.Code == key.ActionPaste
+}
+
+func (e Event) IsClickOn(owner string) bool {
+	return e.IsMouse() && e.Mouse.IsClick() && e.Hit.Is(owner)
+}
+
+func (e Event) Unwrap() Event {
+	if e.Hit != nil {
+		e.Hit = e.Hit.Inner
+	}
+	return e
+}
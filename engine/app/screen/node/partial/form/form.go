@@ -11,6 +11,7 @@ import (
 	"github.com/Rafael24595/go-reacterm-core/engine/helper/math"
 	"github.com/Rafael24595/go-reacterm-core/engine/layout/drawable/decorator/inputline"
 	"github.com/Rafael24595/go-reacterm-core/engine/layout/drawable/stream/pipeline/gutter"
+	"github.com/Rafael24595/go-reacterm-core/engine/layout/drawable/stream/pipeline/target"
 	"github.com/Rafael24595/go-reacterm-core/engine/layout/drawable/widget/form"
 	"github.com/Rafael24595/go-reacterm-core/engine/model/key"
 	"github.com/Rafael24595/go-reacterm-core/engine/model/winsize"
@@ -34,7 +35,10 @@ var sources = screen.NewDefinition(
 		key.ActionArrowRight,
 		key.ActionArrowUp,
 		key.ActionArrowDown,
+		key.ActionTab,
+		key.ActionBackTab,
 		key.CustomActionPointer,
+		key.ActionMouse,
 	},
 )
 
@@ -123,6 +127,10 @@ func (n *Form) keys() screen.Definition {
 }
 
 func (n *Form) tick(uiState *state.UIState, event screen.Event) screen.Result {
+	if event.Key.Code == key.ActionMouse {
+		return n.mouseTick(uiState, event)
+	}
+
 	focus, ok := n.focusItem()
 
 	definition := focus.Node.Screen.Keys()
@@ -153,6 +161,10 @@ func (n *Form) localTick(uiState *state.UIState, event screen.Event) screen.Resu
 	case key.ActionArrowRight:
 		last := math.SubClampZeroAs[int, uint16](len(n.items), 1)
 		n.cursor = min(last, n.cursor+1)
+	case key.ActionTab:
+		n.cursor = n.nextCursor(1)
+	case key.ActionBackTab:
+		n.cursor = n.nextCursor(len(n.items) - 1)
 	case key.ActionEnter:
 		n.focused = true
 	case key.CustomActionPointer:
@@ -162,6 +174,32 @@ func (n *Form) localTick(uiState *state.UIState, event screen.Event) screen.Resu
 	return screen.ResultFromUIState(uiState)
 }
 
+func (n *Form) nextCursor(step int) uint16 {
+	size := len(n.items)
+	if size == 0 {
+		return 0
+	}
+	return uint16((int(n.cursor) + step) % size)
+}
+
+func (n *Form) mouseTick(uiState *state.UIState, event screen.Event) screen.Result {
+	if !event.Hit.Is(n.reference) || event.Hit.Index >= uint16(len(n.items)) {
+		return screen.ResultFromUIState(uiState)
+	}
+
+	if event.IsClickOn(n.reference) {
+		n.cursor = event.Hit.Index
+		n.focused = true
+	}
+
+	focus, ok := n.focusItem()
+	if !ok || !focus.Node.Screen.Keys().IsRequired(event.Key) {
+		return screen.ResultFromUIState(uiState)
+	}
+
+	return n.focusTick(uiState, event.Unwrap(), focus)
+}
+
 func (n *Form) focusTick(uiState *state.UIState, event screen.Event, focus entry.Entry) screen.Result {
 	result := focus.Node.Screen.Tick(uiState, event)
 
@@ -199,11 +237,12 @@ func (n *Form) view(uiState state.UIState) viewmodel.ViewModel {
 	for i, e := range n.items {
 		cvm := e.Node.Screen.View(uiState)
 
-		opts := make([]gutter.Option, 0, 1)
+		opts := make([]gutter.Option, 0, 2)
 
 		if pointer.HasNone(form.PointerGutter) || n.cursor != uint16(i) {
 			opts = append(opts,
 				gutter.WithLeftGutter(gutter.DefaultEmpty),
+				gutter.WithRole(style.RoleGutter),
 			)
 		}
 
@@ -212,6 +251,8 @@ func (n *Form) view(uiState state.UIState) viewmodel.ViewModel {
 			opts...,
 		)
 
+		unit = target.Unit(unit, n.reference, uint16(i))
+
 		vm.Kernel.PushLayer(unit, e.Opts...)
 
 		if cvm.Behavior.NeedsPulse {
@@ -222,7 +263,7 @@ func (n *Form) view(uiState state.UIState) viewmodel.ViewModel {
 	focus, ok := n.focusItem()
 	if ok && pointer.HasAny(form.PointerPrompt) {
 		label := text.NewFragment(focus.Node.Name).
-			AddAtom(style.AtmSelect)
+			SetRole(style.RoleFocus)
 
 		vm.Footer.Push(
 			inputline.FromFragment(*label),
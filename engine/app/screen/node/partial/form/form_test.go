@@ -5,7 +5,16 @@ import (
 
 	assert "github.com/Rafael24595/go-assert/assert/test"
 
+	"github.com/Rafael24595/go-reacterm-core/engine/app/screen"
+	"github.com/Rafael24595/go-reacterm-core/engine/app/state"
+	"github.com/Rafael24595/go-reacterm-core/engine/app/viewmodel"
 	"github.com/Rafael24595/go-reacterm-core/engine/config/entry"
+	"github.com/Rafael24595/go-reacterm-core/engine/layout/drawable/primitive/line"
+	"github.com/Rafael24595/go-reacterm-core/engine/layout/transform/drain"
+	"github.com/Rafael24595/go-reacterm-core/engine/model/key"
+	"github.com/Rafael24595/go-reacterm-core/engine/model/winsize"
+	"github.com/Rafael24595/go-reacterm-core/engine/render/style"
+	"github.com/Rafael24595/go-reacterm-core/engine/render/text"
 
 	screen_test "github.com/Rafael24595/go-reacterm-core/test/engine/app/screen"
 )
@@ -32,3 +41,56 @@ func TestForm_Propagate(t *testing.T) {
 
 	screen_test.Helper_Propagate(t, name, 0, node)
 }
+
+func TestForm_TabTraversal(t *testing.T) {
+	form := New().
+		AddNode(screen_test.MockScreen{Name: "a"}.ToNode(), entry.Selectable()).
+		AddNode(screen_test.MockScreen{Name: "b"}.ToNode(), entry.Selectable()).
+		AddNode(screen_test.MockScreen{Name: "c"}.ToNode(), entry.Selectable())
+
+	node := form.ToNode()
+	uiState := state.NewUIState()
+
+	tab := screen.NewEvent(*key.NewKeyCode(key.ActionTab))
+	backTab := screen.NewEvent(*key.NewKeyCode(key.ActionBackTab))
+
+	node.Screen.Tick(uiState, tab)
+	assert.Equal(t, 1, form.cursor)
+
+	node.Screen.Tick(uiState, backTab)
+	assert.Equal(t, 0, form.cursor)
+
+	node.Screen.Tick(uiState, backTab)
+	assert.Equal(t, 2, form.cursor)
+
+	node.Screen.Tick(uiState, tab)
+	assert.Equal(t, 0, form.cursor)
+}
+
+func TestForm_GutterRoles(t *testing.T) {
+	item := func(name string) screen.Node {
+		return screen_test.MockScreen{
+			Name: name,
+			View: func(state.UIState) viewmodel.ViewModel {
+				vm := viewmodel.New()
+				vm.Kernel.Push(line.UnitFromLines(*text.NewLine(name)))
+				return *vm
+			},
+		}.ToNode()
+	}
+
+	node := New().
+		AddNode(item("a"), entry.Selectable()).
+		AddNode(item("b"), entry.Selectable()).
+		ToNode()
+
+	vm := node.Screen.View(*state.NewUIState())
+
+	kernel := vm.Kernel.ToUnit()
+	kernel.Drawable.Init()
+	lines := drain.UnitEager(winsize.New(10, 20), kernel)
+
+	assert.Len(t, 2, lines)
+	assert.Equal(t, style.RoleGutterActive, lines[0].Text[0].Role)
+	assert.Equal(t, style.RoleGutter, lines[1].Text[0].Role)
+}
@@ -5,12 +5,15 @@ import (
 	"github.com/Rafael24595/go-reacterm-core/engine/app/screen/node/partial/pipeline"
 	"github.com/Rafael24595/go-reacterm-core/engine/app/viewmodel"
 	"github.com/Rafael24595/go-reacterm-core/engine/layout/drawable/stream/pipeline/drain"
+	"github.com/Rafael24595/go-reacterm-core/engine/render/style"
 	"github.com/Rafael24595/go-reacterm-core/engine/render/text"
 )
 
 const Name = "footer_transformer"
 
 func Transformer(placement pipeline.Placement, lines ...text.Line) pipeline.Transformer {
+	lines = text.DefaultLineRole(style.RoleFooter, lines...)
+
 	unit := drain.UnitFromLines(lines...)
 	unit.Name = Name
 
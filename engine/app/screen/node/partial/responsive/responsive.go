@@ -0,0 +1,191 @@
+package responsive
+
+import (
+	"slices"
+
+	"github.com/Rafael24595/go-reacterm-core/engine/app/screen"
+	"github.com/Rafael24595/go-reacterm-core/engine/app/screen/node/partial/template"
+	"github.com/Rafael24595/go-reacterm-core/engine/app/state"
+	"github.com/Rafael24595/go-reacterm-core/engine/app/viewmodel"
+	"github.com/Rafael24595/go-reacterm-core/engine/config/breakpoint"
+	"github.com/Rafael24595/go-reacterm-core/engine/layout/drawable/decorator/responsive"
+	"github.com/Rafael24595/go-reacterm-core/engine/model/winsize"
+)
+
+const Name = "responsive"
+
+const NameTooSmall = "too_small"
+
+const (
+	indexFallback = -1
+	indexBase     = 0
+)
+
+type alternative struct {
+	when breakpoint.Breakpoint
+	node screen.Node
+}
+
+// Responsive hands keys, ticks and the view to the first alternative
+// whose breakpoint matches the terminal size, or to its base node when
+// none does. Under the minimum size the fallback screen takes over.
+type Responsive struct {
+	reference    string
+	base         screen.Node
+	alternatives []alternative
+	min          *winsize.Winsize
+	fallback     screen.Node
+	size         winsize.Winsize
+}
+
+func New(base screen.Node) *Responsive {
+	return &Responsive{
+		reference:    Name,
+		base:         base,
+		alternatives: make([]alternative, 0),
+		min:          nil,
+		fallback:     screen.Node{},
+		size:         winsize.Winsize{},
+	}
+}
+
+func (n *Responsive) SetName(name string) *Responsive {
+	n.reference = name
+	return n
+}
+
+func (n *Responsive) When(when breakpoint.Breakpoint, node screen.Node) *Responsive {
+	n.alternatives = append(n.alternatives, alternative{
+		when: when,
+		node: node,
+	})
+	return n
+}
+
+// MinSize sets the size the node needs to work. Below it the fallback
+// is shown instead, a too small notice unless another one is given.
+func (n *Responsive) MinSize(min winsize.Winsize, fallback ...screen.Node) *Responsive {
+	n.min = &min
+	n.fallback = TooSmall(min)
+	if len(fallback) > 0 {
+		n.fallback = fallback[0]
+	}
+	return n
+}
+
+// TooSmall builds a screen telling the user the terminal is under min.
+func TooSmall(min winsize.Winsize) screen.Node {
+	vm := viewmodel.New()
+	vm.Kernel.Push(
+		responsive.TooSmall(min),
+	)
+
+	return template.New().
+		Name(NameTooSmall).
+		ViewModel(*vm).
+		ToNode()
+}
+
+func (n *Responsive) ToNode() screen.Node {
+	builder := screen.NewBuilder().
+		Name(n.reference).
+		NameToStack().
+		Init(n.init).
+		Keys(n.keys).
+		Tick(n.tick).
+		View(n.view)
+
+	for _, v := range n.nodes() {
+		builder.Children(v).
+			AddStack(v.Stack)
+	}
+
+	return builder.ToNode()
+}
+
+func (n *Responsive) nodes() []screen.Node {
+	nodes := make([]screen.Node, 0, len(n.alternatives)+2)
+	nodes = append(nodes, n.base)
+	for _, v := range n.alternatives {
+		nodes = append(nodes, v.node)
+	}
+	if n.min != nil {
+		nodes = append(nodes, n.fallback)
+	}
+	return nodes
+}
+
+func (n *Responsive) init(uiState state.UIState) {
+	n.size = uiState.Size
+
+	for _, node := range n.nodes() {
+		node.Screen.Init(uiState)
+	}
+}
+
+// keys has no state to read the size from, so it follows the last one
+// the node was initialized, ticked or drawn with.
+func (n *Responsive) keys() screen.Definition {
+	return n.node(n.pick(n.size)).Screen.Keys()
+}
+
+func (n *Responsive) tick(uiState *state.UIState, event screen.Event) screen.Result {
+	n.size = uiState.Size
+
+	index := n.pick(n.size)
+	result := n.node(index).Screen.Tick(uiState, event)
+
+	if result.Node == nil {
+		return result
+	}
+
+	newWrapper := New(n.base)
+	newWrapper.reference = n.reference
+	newWrapper.alternatives = slices.Clone(n.alternatives)
+	newWrapper.min = n.min
+	newWrapper.fallback = n.fallback
+	newWrapper.size = n.size
+
+	switch index {
+	case indexFallback:
+		newWrapper.fallback = *result.Node
+	case indexBase:
+		newWrapper.base = *result.Node
+	default:
+		newWrapper.alternatives[index-1].node = *result.Node
+	}
+
+	newNode := newWrapper.ToNode()
+	result.Node = &newNode
+
+	return result
+}
+
+func (n *Responsive) view(uiState state.UIState) viewmodel.ViewModel {
+	n.size = uiState.Size
+	return n.node(n.pick(n.size)).Screen.View(uiState)
+}
+
+func (n *Responsive) pick(size winsize.Winsize) int {
+	if n.min != nil && !breakpoint.MinSize(*n.min)(size) {
+		return indexFallback
+	}
+
+	cases := make([]breakpoint.Case[int], len(n.alternatives))
+	for i, v := range n.alternatives {
+		cases[i] = breakpoint.When(v.when, i+1)
+	}
+
+	index, _ := breakpoint.Pick(size, indexBase, cases...)
+	return index
+}
+
+func (n *Responsive) node(index int) screen.Node {
+	switch index {
+	case indexFallback:
+		return n.fallback
+	case indexBase:
+		return n.base
+	}
+	return n.alternatives[index-1].node
+}
@@ -0,0 +1,119 @@
+package responsive
+
+import (
+	"fmt"
+	"testing"
+
+	assert "github.com/Rafael24595/go-assert/assert/test"
+
+	"github.com/Rafael24595/go-reacterm-core/engine/app/screen"
+	"github.com/Rafael24595/go-reacterm-core/engine/app/state"
+	"github.com/Rafael24595/go-reacterm-core/engine/config/breakpoint"
+	"github.com/Rafael24595/go-reacterm-core/engine/model/key"
+	"github.com/Rafael24595/go-reacterm-core/engine/model/winsize"
+
+	screen_test "github.com/Rafael24595/go-reacterm-core/test/engine/app/screen"
+)
+
+func recorder(name string, received *[]string) screen.Node {
+	keys := screen.DefinitionFromActions(key.ActionEnter)
+	return screen_test.MockScreen{
+		Name: name,
+		Keys: &keys,
+		Tick: func(s *state.UIState, e screen.Event) screen.Result {
+			*received = append(*received, name)
+			return screen.ResultFromUIState(s)
+		},
+	}.ToNode()
+}
+
+func press(action key.Action) screen.Event {
+	return screen.NewEvent(*key.NewKeyCode(action))
+}
+
+func TestResponsive_ToNode(t *testing.T) {
+	node := New(screen_test.MockScreen{Name: "base"}.ToNode()).ToNode()
+	screen_test.Helper_ToNode(t, node)
+
+	assert.Equal(t, Name, node.Name)
+}
+
+func TestResponsive_KeepsAllStacks(t *testing.T) {
+	node := New(screen_test.MockScreen{Name: "narrow"}.ToNode()).
+		When(breakpoint.MinCols(80), screen_test.MockScreen{Name: "wide"}.ToNode()).
+		MinSize(winsize.New(5, 20)).
+		ToNode()
+
+	screen_test.Helper_Propagate(t, "narrow", 0, node)
+	screen_test.Helper_Propagate(t, "wide", 1, node)
+	screen_test.Helper_Propagate(t, NameTooSmall, 2, node)
+	assert.True(t, node.Stack.Has(Name))
+}
+
+func TestResponsive_RoutesBySize(t *testing.T) {
+	received := make([]string, 0)
+
+	node := New(recorder("narrow", &received)).
+		When(breakpoint.MinCols(80), recorder("wide", &received)).
+		MinSize(winsize.New(5, 20), recorder("fallback", &received)).
+		ToNode()
+
+	uiState := state.NewUIState()
+	uiState.Size = winsize.New(24, 40)
+	node.Screen.Init(*uiState)
+
+	node.Screen.Tick(uiState, press(key.ActionEnter))
+
+	uiState.Size = winsize.New(24, 120)
+	node.Screen.Tick(uiState, press(key.ActionEnter))
+
+	uiState.Size = winsize.New(4, 120)
+	node.Screen.Tick(uiState, press(key.ActionEnter))
+
+	assert.Equal(t, "[narrow wide fallback]", fmt.Sprint(received))
+}
+
+func TestResponsive_DefaultFallback(t *testing.T) {
+	node := New(screen_test.MockScreen{Name: "base"}.ToNode()).
+		MinSize(winsize.New(10, 40)).
+		ToNode()
+
+	uiState := state.NewUIState()
+	uiState.Size = winsize.New(5, 30)
+	node.Screen.Init(*uiState)
+
+	vm := node.Screen.View(*uiState)
+	assert.Equal(t, 1, vm.Kernel.Size())
+
+	uiState.Size = winsize.New(10, 40)
+	vm = node.Screen.View(*uiState)
+	assert.Equal(t, 0, vm.Kernel.Size())
+}
+
+func TestResponsive_ReplacesPickedNode(t *testing.T) {
+	next := screen_test.MockScreen{Name: "next"}.ToNode()
+	keys := screen.DefinitionFromActions(key.ActionEnter)
+
+	wide := screen_test.MockScreen{
+		Name: "wide",
+		Keys: &keys,
+		Tick: func(s *state.UIState, e screen.Event) screen.Result {
+			return screen.ResultFromNode(&next)
+		},
+	}.ToNode()
+
+	node := New(screen_test.MockScreen{Name: "narrow"}.ToNode()).
+		When(breakpoint.MinCols(80), wide).
+		ToNode()
+
+	uiState := state.NewUIState()
+	uiState.Size = winsize.New(24, 120)
+	node.Screen.Init(*uiState)
+
+	result := node.Screen.Tick(uiState, press(key.ActionEnter))
+
+	assert.NotNil(t, result.Node)
+	assert.Equal(t, Name, result.Node.Name)
+	screen_test.Helper_Propagate(t, "narrow", 0, *result.Node)
+	screen_test.Helper_Propagate(t, "next", 1, *result.Node)
+}
@@ -0,0 +1,363 @@
+package split
+
+import (
+	"slices"
+
+	"github.com/Rafael24595/go-reacterm-core/engine/app/screen"
+	"github.com/Rafael24595/go-reacterm-core/engine/app/state"
+	"github.com/Rafael24595/go-reacterm-core/engine/app/viewmodel"
+	"github.com/Rafael24595/go-reacterm-core/engine/config/layer"
+	"github.com/Rafael24595/go-reacterm-core/engine/layout/drawable"
+	"github.com/Rafael24595/go-reacterm-core/engine/layout/drawable/decorator/inputline"
+	"github.com/Rafael24595/go-reacterm-core/engine/layout/drawable/spatial/stack"
+	"github.com/Rafael24595/go-reacterm-core/engine/layout/drawable/widget/split"
+	"github.com/Rafael24595/go-reacterm-core/engine/model/key"
+	"github.com/Rafael24595/go-reacterm-core/engine/model/param"
+	"github.com/Rafael24595/go-reacterm-core/engine/model/winsize"
+	"github.com/Rafael24595/go-reacterm-core/engine/render/style"
+	"github.com/Rafael24595/go-reacterm-core/engine/render/text"
+)
+
+const Name = "split"
+
+const ArgRatios param.Typed[[]uint8] = "id_split_ratios"
+
+var paneSources = screen.NewDefinition(
+	map[key.Action]key.Descriptor{
+		key.ActionEsc:     {Code: []string{"ESC"}, Detail: "Resize Mode"},
+		key.ActionTab:     {Code: []string{"TAB"}, Detail: "Next pane"},
+		key.ActionBackTab: {Code: []string{"S-TAB"}, Detail: "Previous pane"},
+	},
+	[]key.Action{
+		key.ActionEsc,
+		key.ActionTab,
+		key.ActionBackTab,
+	},
+)
+
+var resizeSources = screen.NewDefinition(
+	map[key.Action]key.Descriptor{
+		key.ActionEnter:      {Code: []string{"RET"}, Detail: "Pane Mode"},
+		key.ActionTab:        {Code: []string{"TAB"}, Detail: "Next pane"},
+		key.ActionArrowLeft:  {Code: []string{"←", "↑"}, Detail: "Shrink pane"},
+		key.ActionArrowRight: {Code: []string{"→", "↓"}, Detail: "Grow pane"},
+	},
+	[]key.Action{
+		key.ActionEnter,
+		key.ActionTab,
+		key.ActionBackTab,
+		key.ActionArrowLeft,
+		key.ActionArrowRight,
+		key.ActionArrowUp,
+		key.ActionArrowDown,
+	},
+)
+
+// Split lays its panes side by side, or one over the other, with a
+// divider between them. Keys go to the focused pane only; in resize
+// mode the arrows move its dividers instead. Each pane keeps the header
+// and footer of its node but not its pager, so a node whose content may
+// overflow its pane should wrap it in a viewport.
+type Split struct {
+	reference string
+	vertical  bool
+	resizing  bool
+	cursor    uint16
+	step      uint8
+	ratios    []uint8
+	panes     []screen.Node
+}
+
+func New() *Split {
+	return &Split{
+		reference: Name,
+		vertical:  false,
+		resizing:  false,
+		cursor:    0,
+		step:      split.DefaultStep,
+		ratios:    make([]uint8, 0),
+		panes:     make([]screen.Node, 0),
+	}
+}
+
+func (n *Split) SetName(name string) *Split {
+	n.reference = name
+	return n
+}
+
+// Vertical stacks the panes one over the other instead of side by side.
+func (n *Split) Vertical() *Split {
+	n.vertical = true
+	return n
+}
+
+func (n *Split) SetStep(step uint8) *Split {
+	n.step = step
+	return n
+}
+
+// SetRatios sets the starting share of each pane, in percent. They are
+// ignored unless there is one per pane and they add up to a hundred.
+func (n *Split) SetRatios(ratios ...uint8) *Split {
+	n.ratios = ratios
+	return n
+}
+
+func (n *Split) AddNode(nodes ...screen.Node) *Split {
+	n.panes = append(n.panes, nodes...)
+	return n
+}
+
+func (n *Split) ToNode() screen.Node {
+	builder := screen.NewBuilder().
+		Name(n.reference).
+		NameToStack().
+		Init(n.init).
+		Keys(n.keys).
+		Tick(n.tick).
+		View(n.view)
+
+	for _, v := range n.panes {
+		builder.Children(v).
+			AddStack(v.Stack)
+	}
+
+	return builder.ToNode()
+}
+
+func (n *Split) init(uiState state.UIState) {
+	for _, pane := range n.panes {
+		pane.Screen.Init(uiState)
+	}
+
+	ratios, ok := state.FindParam(
+		uiState.Stack,
+		n.reference,
+		ArgRatios,
+	)
+
+	if ok && split.ValidRatios(ratios, len(n.panes)) {
+		n.ratios = slices.Clone(ratios)
+	}
+
+	if !split.ValidRatios(n.ratios, len(n.panes)) {
+		n.ratios = split.EvenRatios(len(n.panes))
+	}
+}
+
+func (n *Split) keys() screen.Definition {
+	if n.resizing {
+		return resizeSources
+	}
+
+	local := paneSources
+
+	focus, ok := n.focusPane()
+	if ok {
+		local = local.Merge(
+			focus.Screen.Keys(),
+		)
+	}
+
+	return local
+}
+
+func (n *Split) tick(uiState *state.UIState, event screen.Event) screen.Result {
+	focus, ok := n.focusPane()
+
+	required := ok && !n.resizing && focus.Screen.Keys().IsRequired(event.Key)
+	if required {
+		result := n.focusTick(uiState, event, focus)
+		if event.Key.Code != key.ActionEsc {
+			return result
+		}
+	}
+
+	return n.localTick(uiState, event)
+}
+
+func (n *Split) localTick(uiState *state.UIState, event screen.Event) screen.Result {
+	ky := event.Key
+
+	switch ky.Code {
+	case key.ActionEsc:
+		n.resizing = true
+	case key.ActionEnter:
+		n.resizing = false
+	case key.ActionTab:
+		n.cursor = n.nextCursor(1)
+	case key.ActionBackTab:
+		n.cursor = n.nextCursor(len(n.panes) - 1)
+	case key.ActionArrowLeft, key.ActionArrowUp:
+		n.resize(uiState, false)
+	case key.ActionArrowRight, key.ActionArrowDown:
+		n.resize(uiState, true)
+	}
+
+	return screen.ResultFromUIState(uiState)
+}
+
+func (n *Split) resize(uiState *state.UIState, grow bool) {
+	if !n.resizing {
+		return
+	}
+
+	if !split.ValidRatios(n.ratios, len(n.panes)) {
+		n.ratios = split.EvenRatios(len(n.panes))
+	}
+
+	changed := split.Resize(n.ratios, int(n.cursor), grow, n.step, split.DefaultMinRatio)
+	if !changed {
+		return
+	}
+
+	state.PushParam(
+		uiState.Stack,
+		n.reference,
+		ArgRatios,
+		slices.Clone(n.ratios),
+	)
+}
+
+func (n *Split) nextCursor(step int) uint16 {
+	size := len(n.panes)
+	if size == 0 {
+		return 0
+	}
+	return uint16((int(n.cursor) + step) % size)
+}
+
+func (n *Split) focusTick(uiState *state.UIState, event screen.Event, focus screen.Node) screen.Result {
+	result := focus.Screen.Tick(uiState, event)
+
+	if result.Node == nil {
+		return result
+	}
+
+	newWrapper := New()
+	newWrapper.reference = n.reference
+	newWrapper.vertical = n.vertical
+	newWrapper.resizing = n.resizing
+	newWrapper.cursor = n.cursor
+	newWrapper.step = n.step
+	newWrapper.ratios = slices.Clone(n.ratios)
+	newWrapper.panes = slices.Clone(n.panes)
+
+	newNode := newWrapper.ToNode()
+	result.Node = &newNode
+
+	return result
+}
+
+func (n *Split) view(uiState state.UIState) viewmodel.ViewModel {
+	vm := viewmodel.New()
+
+	ratios := n.ratios
+	if !split.ValidRatios(ratios, len(n.panes)) {
+		ratios = split.EvenRatios(len(n.panes))
+	}
+
+	units := make([]drawable.Unit, len(n.panes))
+	for i, pane := range n.panes {
+		cvm := pane.Screen.View(uiState)
+
+		pane := split.NewPane(cvm.Kernel.ToUnit())
+		if cvm.Header.Size() > 0 {
+			pane.Header(cvm.Header.ToUnit())
+		}
+		if cvm.Footer.Size() > 0 {
+			pane.Footer(cvm.Footer.ToUnit())
+		}
+
+		units[i] = pane.ToUnit()
+
+		vm.Overlays = append(vm.Overlays, cvm.Overlays...)
+
+		if cvm.Behavior.NeedsPulse {
+			vm.Behavior.NeedsPulse = true
+		}
+	}
+
+	if n.vertical {
+		vm.Kernel.Push(n.makeVStack(units, ratios))
+	} else {
+		vm.Kernel.Push(n.makeHStack(units, ratios))
+	}
+
+	focus, ok := n.focusPane()
+	if ok && n.resizing {
+		label := text.NewFragment(focus.Name).
+			SetRole(style.RoleFocus)
+
+		vm.Footer.Push(
+			inputline.FromFragment(*label),
+		)
+	}
+
+	return *vm
+}
+
+// The last pane takes whatever the others and the dividers leave, so
+// rounding never pushes the layout past the frame.
+func (n *Split) makeHStack(units []drawable.Unit, ratios []uint8) drawable.Unit {
+	hstack := stack.NewHStack()
+	for i, unit := range units {
+		if i > 0 {
+			hstack.PushLayer(
+				n.makeDivider(i),
+				layer.Fixed[winsize.Cols](1),
+			)
+		}
+
+		opts := make([]layer.Option[winsize.Cols], 0, 1)
+		if i < len(units)-1 {
+			opts = append(opts, layer.Percent(winsize.Cols(ratios[i])))
+		}
+
+		hstack.PushLayer(unit, opts...)
+	}
+
+	return hstack.ToUnit()
+}
+
+func (n *Split) makeVStack(units []drawable.Unit, ratios []uint8) drawable.Unit {
+	vstack := stack.NewVStack()
+	for i, unit := range units {
+		if i > 0 {
+			vstack.PushLayer(
+				n.makeDivider(i),
+				layer.Fixed[winsize.Rows](1),
+			)
+		}
+
+		opts := make([]layer.Option[winsize.Rows], 0, 1)
+		if i < len(units)-1 {
+			opts = append(opts, layer.Percent(winsize.Rows(ratios[i])))
+		}
+
+		vstack.PushLayer(unit, opts...)
+	}
+
+	return vstack.ToUnit()
+}
+
+// makeDivider draws the divider before the pane at index, highlighted
+// when it borders the focused pane.
+func (n *Split) makeDivider(index int) drawable.Unit {
+	role := style.RoleBorder
+	if int(n.cursor) == index || int(n.cursor) == index-1 {
+		role = style.RoleGutterActive
+	}
+
+	return split.NewDivider(n.vertical).
+		SetRole(role).
+		ToUnit()
+}
+
+func (n *Split) focusPane() (screen.Node, bool) {
+	if n.cursor >= uint16(len(n.panes)) {
+		return screen.Node{}, false
+	}
+
+	return n.panes[n.cursor], true
+}
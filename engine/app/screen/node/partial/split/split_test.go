@@ -0,0 +1,170 @@
+package split
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	assert "github.com/Rafael24595/go-assert/assert/test"
+
+	"github.com/Rafael24595/go-reacterm-core/engine/app/screen"
+	"github.com/Rafael24595/go-reacterm-core/engine/app/state"
+	"github.com/Rafael24595/go-reacterm-core/engine/app/viewmodel"
+	"github.com/Rafael24595/go-reacterm-core/engine/layout/drawable/primitive/line"
+	"github.com/Rafael24595/go-reacterm-core/engine/model/key"
+	"github.com/Rafael24595/go-reacterm-core/engine/model/winsize"
+	"github.com/Rafael24595/go-reacterm-core/engine/render/text"
+
+	screen_test "github.com/Rafael24595/go-reacterm-core/test/engine/app/screen"
+)
+
+func recorder(name string, keys screen.Definition, received *[]string) screen.Node {
+	return screen_test.MockScreen{
+		Name: name,
+		Keys: &keys,
+		Tick: func(s *state.UIState, e screen.Event) screen.Result {
+			*received = append(*received, name)
+			return screen.ResultFromUIState(s)
+		},
+	}.ToNode()
+}
+
+func press(action key.Action) screen.Event {
+	return screen.NewEvent(*key.NewKeyCode(action))
+}
+
+func TestSplit_ToNode(t *testing.T) {
+	node := New().ToNode()
+	screen_test.Helper_ToNode(t, node)
+
+	assert.Equal(t, Name, node.Name)
+}
+
+func TestSplit_Propagate(t *testing.T) {
+	node := New().
+		AddNode(
+			screen_test.MockScreen{Name: "left"}.ToNode(),
+			screen_test.MockScreen{Name: "right"}.ToNode(),
+		).
+		ToNode()
+
+	screen_test.Helper_Propagate(t, "left", 0, node)
+	screen_test.Helper_Propagate(t, "right", 1, node)
+}
+
+func TestSplit_RoutesToFocusedPane(t *testing.T) {
+	received := make([]string, 0)
+	keys := screen.DefinitionFromActions(key.ActionArrowDown)
+
+	split := New().AddNode(
+		recorder("left", keys, &received),
+		recorder("right", keys, &received),
+	)
+
+	node := split.ToNode()
+	uiState := state.NewUIState()
+	node.Screen.Init(*uiState)
+
+	node.Screen.Tick(uiState, press(key.ActionArrowDown))
+	node.Screen.Tick(uiState, press(key.ActionTab))
+	node.Screen.Tick(uiState, press(key.ActionArrowDown))
+
+	assert.Equal(t, "[left right]", fmt.Sprint(received))
+	assert.Equal(t, 1, split.cursor)
+
+	node.Screen.Tick(uiState, press(key.ActionBackTab))
+	assert.Equal(t, 0, split.cursor)
+}
+
+func TestSplit_ResizePersistsRatios(t *testing.T) {
+	received := make([]string, 0)
+	keys := screen.DefinitionFromActions(key.ActionArrowRight)
+
+	split := New().AddNode(
+		recorder("left", keys, &received),
+		recorder("right", keys, &received),
+	)
+
+	node := split.ToNode()
+	uiState := state.NewUIState()
+	node.Screen.Init(*uiState)
+
+	node.Screen.Tick(uiState, press(key.ActionEsc))
+	assert.True(t, split.resizing)
+
+	node.Screen.Tick(uiState, press(key.ActionArrowRight))
+	node.Screen.Tick(uiState, press(key.ActionArrowRight))
+
+	assert.Len(t, 0, received)
+	assert.Equal(t, "[60 40]", fmt.Sprint(split.ratios))
+
+	ratios, ok := state.FindParam(uiState.Stack, Name, ArgRatios)
+	assert.True(t, ok)
+	assert.Equal(t, "[60 40]", fmt.Sprint(ratios))
+
+	node.Screen.Tick(uiState, press(key.ActionEnter))
+	node.Screen.Tick(uiState, press(key.ActionArrowRight))
+
+	assert.False(t, split.resizing)
+	assert.Equal(t, "[left]", fmt.Sprint(received))
+
+	restored := New().AddNode(
+		recorder("left", keys, &received),
+		recorder("right", keys, &received),
+	)
+
+	restored.ToNode().Screen.Init(*uiState)
+	assert.Equal(t, "[60 40]", fmt.Sprint(restored.ratios))
+}
+
+func TestSplit_SetRatios(t *testing.T) {
+	split := New().
+		AddNode(
+			screen_test.MockScreen{Name: "a"}.ToNode(),
+			screen_test.MockScreen{Name: "b"}.ToNode(),
+		).
+		SetRatios(30, 70)
+
+	split.ToNode().Screen.Init(*state.NewUIState())
+	assert.Equal(t, "[30 70]", fmt.Sprint(split.ratios))
+
+	invalid := New().
+		AddNode(
+			screen_test.MockScreen{Name: "a"}.ToNode(),
+			screen_test.MockScreen{Name: "b"}.ToNode(),
+		).
+		SetRatios(30, 30)
+
+	invalid.ToNode().Screen.Init(*state.NewUIState())
+	assert.Equal(t, "[50 50]", fmt.Sprint(invalid.ratios))
+}
+
+func TestSplit_KeepsPaneHeaderAndFooter(t *testing.T) {
+	pane := screen_test.MockScreen{
+		Name: "pane",
+		View: func(s state.UIState) viewmodel.ViewModel {
+			vm := viewmodel.New()
+			vm.Header.Push(line.UnitFromLines(*text.NewLine("head")))
+			vm.Kernel.Push(line.UnitFromLines(*text.NewLine("body")))
+			vm.Footer.Push(line.UnitFromLines(*text.NewLine("foot")))
+			return *vm
+		},
+	}.ToNode()
+
+	node := New().AddNode(pane).ToNode()
+	uiState := state.NewUIState()
+	node.Screen.Init(*uiState)
+
+	vm := node.Screen.View(*uiState)
+	unit := vm.Kernel.ToUnit()
+	unit.Drawable.Init()
+
+	lines, _ := unit.Drawable.Draw(winsize.New(4, 4))
+
+	result := make([]string, len(lines))
+	for i := range lines {
+		result[i] = text.LineToString(&lines[i])
+	}
+
+	assert.Equal(t, "head|body|    |foot", strings.Join(result, "|"))
+}
@@ -0,0 +1,210 @@
+package tabs
+
+import (
+	"slices"
+
+	"github.com/Rafael24595/go-reacterm-core/engine/app/screen"
+	"github.com/Rafael24595/go-reacterm-core/engine/app/state"
+	"github.com/Rafael24595/go-reacterm-core/engine/app/viewmodel"
+	"github.com/Rafael24595/go-reacterm-core/engine/layout/drawable/spatial/stack"
+	"github.com/Rafael24595/go-reacterm-core/engine/layout/drawable/widget/tabs"
+	"github.com/Rafael24595/go-reacterm-core/engine/model/key"
+	"github.com/Rafael24595/go-reacterm-core/engine/model/param"
+)
+
+const Name = "tabs"
+
+const ArgActiveTab param.Typed[string] = "id_tabs_active"
+
+var sources = screen.DefinitionFromActions(
+	key.CustomActionNextTab,
+	key.CustomActionPrevTab,
+)
+
+type tab struct {
+	label string
+	node  screen.Node
+}
+
+// Tabs shows one child at a time under a strip with every label. Only
+// the active child ticks and draws, but all of them stay in the stack
+// so the cleaner keeps the context of the inactive ones.
+type Tabs struct {
+	reference string
+	cursor    uint16
+	tabs      []tab
+}
+
+func New() *Tabs {
+	return &Tabs{
+		reference: Name,
+		cursor:    0,
+		tabs:      make([]tab, 0),
+	}
+}
+
+func (n *Tabs) SetName(name string) *Tabs {
+	n.reference = name
+	return n
+}
+
+func (n *Tabs) AddTab(label string, node screen.Node) *Tabs {
+	n.tabs = append(n.tabs, tab{
+		label: label,
+		node:  node,
+	})
+	return n
+}
+
+// AddNode adds a tab per node, labelled with the node name.
+func (n *Tabs) AddNode(nodes ...screen.Node) *Tabs {
+	for _, node := range nodes {
+		n.AddTab(node.Name, node)
+	}
+	return n
+}
+
+func (n *Tabs) ToNode() screen.Node {
+	builder := screen.NewBuilder().
+		Name(n.reference).
+		NameToStack().
+		Init(n.init).
+		Keys(n.keys).
+		Tick(n.tick).
+		View(n.view)
+
+	for _, v := range n.tabs {
+		builder.Children(v.node).
+			AddStack(v.node.Stack)
+	}
+
+	return builder.ToNode()
+}
+
+func (n *Tabs) init(uiState state.UIState) {
+	for _, tab := range n.tabs {
+		tab.node.Screen.Init(uiState)
+	}
+
+	label, ok := state.FindParam(
+		uiState.Stack,
+		n.reference,
+		ArgActiveTab,
+	)
+
+	if !ok {
+		return
+	}
+
+	for i, tab := range n.tabs {
+		if tab.label == label {
+			n.cursor = uint16(i)
+			break
+		}
+	}
+}
+
+func (n *Tabs) keys() screen.Definition {
+	local := sources
+
+	active, ok := n.activeTab()
+	if ok {
+		local = local.Merge(
+			active.node.Screen.Keys(),
+		)
+	}
+
+	return local
+}
+
+func (n *Tabs) tick(uiState *state.UIState, event screen.Event) screen.Result {
+	switch event.Key.Code {
+	case key.CustomActionNextTab:
+		n.moveCursor(uiState, 1)
+		return screen.ResultFromUIState(uiState)
+	case key.CustomActionPrevTab:
+		n.moveCursor(uiState, len(n.tabs)-1)
+		return screen.ResultFromUIState(uiState)
+	}
+
+	active, ok := n.activeTab()
+	if !ok || !active.node.Screen.Keys().IsRequired(event.Key) {
+		return screen.ResultFromUIState(uiState)
+	}
+
+	return n.activeTick(uiState, event, active)
+}
+
+func (n *Tabs) moveCursor(uiState *state.UIState, step int) {
+	size := len(n.tabs)
+	if size == 0 {
+		return
+	}
+
+	n.cursor = uint16((int(n.cursor) + step) % size)
+
+	state.PushParam(
+		uiState.Stack,
+		n.reference,
+		ArgActiveTab,
+		n.tabs[n.cursor].label,
+	)
+}
+
+// activeTick keeps navigation inside the tab: a node returned by the
+// active child replaces that tab instead of the whole container.
+func (n *Tabs) activeTick(uiState *state.UIState, event screen.Event, active tab) screen.Result {
+	result := active.node.Screen.Tick(uiState, event)
+
+	if result.Node == nil {
+		return result
+	}
+
+	newTabs := slices.Clone(n.tabs)
+	newTabs[n.cursor] = tab{
+		label: active.label,
+		node:  *result.Node,
+	}
+
+	newWrapper := New()
+	newWrapper.reference = n.reference
+	newWrapper.cursor = n.cursor
+	newWrapper.tabs = newTabs
+
+	newNode := newWrapper.ToNode()
+	result.Node = &newNode
+
+	return result
+}
+
+func (n *Tabs) view(uiState state.UIState) viewmodel.ViewModel {
+	active, ok := n.activeTab()
+	if !ok {
+		return *viewmodel.New()
+	}
+
+	vm := active.node.Screen.View(uiState)
+
+	labels := make([]string, len(n.tabs))
+	for i, tab := range n.tabs {
+		labels[i] = tab.label
+	}
+
+	header := stack.NewVStack(
+		tabs.Strip(labels, int(n.cursor)),
+	)
+
+	vm.Header = header.Push(
+		vm.Header.Units()...,
+	)
+
+	return vm
+}
+
+func (n *Tabs) activeTab() (tab, bool) {
+	if n.cursor >= uint16(len(n.tabs)) {
+		return tab{}, false
+	}
+
+	return n.tabs[n.cursor], true
+}
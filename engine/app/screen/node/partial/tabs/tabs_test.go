@@ -0,0 +1,155 @@
+package tabs
+
+import (
+	"fmt"
+	"testing"
+
+	assert "github.com/Rafael24595/go-assert/assert/test"
+
+	"github.com/Rafael24595/go-reacterm-core/engine/app/screen"
+	"github.com/Rafael24595/go-reacterm-core/engine/app/state"
+	"github.com/Rafael24595/go-reacterm-core/engine/model/key"
+
+	screen_test "github.com/Rafael24595/go-reacterm-core/test/engine/app/screen"
+)
+
+func recorder(name string, received *[]string) screen.Node {
+	keys := screen.DefinitionFromActions(key.ActionEnter)
+	return screen_test.MockScreen{
+		Name: name,
+		Keys: &keys,
+		Tick: func(s *state.UIState, e screen.Event) screen.Result {
+			*received = append(*received, name)
+			return screen.ResultFromUIState(s)
+		},
+	}.ToNode()
+}
+
+func press(action key.Action) screen.Event {
+	return screen.NewEvent(*key.NewKeyCode(action))
+}
+
+func TestTabs_ToNode(t *testing.T) {
+	node := New().ToNode()
+	screen_test.Helper_ToNode(t, node)
+
+	assert.Equal(t, Name, node.Name)
+}
+
+func TestTabs_KeepsInactiveStacks(t *testing.T) {
+	node := New().
+		AddNode(
+			screen_test.MockScreen{Name: "logs"}.ToNode(),
+			screen_test.MockScreen{Name: "editor"}.ToNode(),
+		).
+		ToNode()
+
+	screen_test.Helper_Propagate(t, "logs", 0, node)
+	screen_test.Helper_Propagate(t, "editor", 1, node)
+	assert.True(t, node.Stack.Has(Name))
+}
+
+func TestTabs_RoutesToActiveTab(t *testing.T) {
+	received := make([]string, 0)
+
+	tabs := New().AddNode(
+		recorder("logs", &received),
+		recorder("editor", &received),
+	)
+
+	node := tabs.ToNode()
+	uiState := state.NewUIState()
+	node.Screen.Init(*uiState)
+
+	node.Screen.Tick(uiState, press(key.ActionEnter))
+	node.Screen.Tick(uiState, press(key.CustomActionNextTab))
+	node.Screen.Tick(uiState, press(key.ActionEnter))
+	node.Screen.Tick(uiState, press(key.ActionArrowDown))
+
+	assert.Equal(t, "[logs editor]", fmt.Sprint(received))
+
+	node.Screen.Tick(uiState, press(key.CustomActionNextTab))
+	assert.Equal(t, 0, tabs.cursor)
+
+	node.Screen.Tick(uiState, press(key.CustomActionPrevTab))
+	assert.Equal(t, 1, tabs.cursor)
+}
+
+func TestTabs_RestoresActiveTab(t *testing.T) {
+	received := make([]string, 0)
+	uiState := state.NewUIState()
+
+	node := New().
+		AddTab("Logs", recorder("logs", &received)).
+		AddTab("Editor", recorder("editor", &received)).
+		ToNode()
+
+	node.Screen.Init(*uiState)
+	node.Screen.Tick(uiState, press(key.CustomActionNextTab))
+
+	label, ok := state.FindParam(uiState.Stack, Name, ArgActiveTab)
+	assert.True(t, ok)
+	assert.Equal(t, "Editor", label)
+
+	restored := New().
+		AddTab("Logs", recorder("logs", &received)).
+		AddTab("Editor", recorder("editor", &received))
+
+	restored.ToNode().Screen.Init(*uiState)
+	assert.Equal(t, 1, restored.cursor)
+}
+
+func TestTabs_NavigationStaysInTab(t *testing.T) {
+	next := screen_test.MockScreen{Name: "details"}.ToNode()
+	keys := screen.DefinitionFromActions(key.ActionEnter)
+
+	list := screen_test.MockScreen{
+		Name: "list",
+		Keys: &keys,
+		Tick: func(s *state.UIState, e screen.Event) screen.Result {
+			return screen.ResultFromNode(&next)
+		},
+	}.ToNode()
+
+	node := New().
+		AddNode(list, screen_test.MockScreen{Name: "other"}.ToNode()).
+		ToNode()
+
+	uiState := state.NewUIState()
+	node.Screen.Init(*uiState)
+
+	result := node.Screen.Tick(uiState, press(key.ActionEnter))
+
+	assert.NotNil(t, result.Node)
+	assert.Equal(t, Name, result.Node.Name)
+	assert.Equal(t, "details", result.Node.Children()[0].Name)
+	assert.Equal(t, "other", result.Node.Children()[1].Name)
+	assert.True(t, result.Node.Stack.Has("other"))
+	assert.False(t, result.Node.Stack.Has("list"))
+}
+
+func TestTabs_KeysMergeActiveTab(t *testing.T) {
+	received := make([]string, 0)
+
+	node := New().
+		AddNode(recorder("logs", &received)).
+		ToNode()
+
+	keys := node.Screen.Keys()
+
+	assert.True(t, keys.IsRequired(*key.NewKeyCode(key.CustomActionNextTab)))
+	assert.True(t, keys.IsRequired(*key.NewKeyCode(key.ActionEnter)))
+	assert.False(t, keys.IsRequired(*key.NewKeyCode(key.ActionArrowUp)))
+}
+
+func TestTabs_ViewPrependsStrip(t *testing.T) {
+	node := New().
+		AddNode(
+			screen_test.MockScreen{Name: "logs"}.ToNode(),
+			screen_test.MockScreen{Name: "editor"}.ToNode(),
+		).
+		ToNode()
+
+	vm := node.Screen.View(*state.NewUIState())
+	assert.Equal(t, 1, vm.Header.Size())
+}
@@ -30,6 +30,7 @@ var read_definition = screen.NewDefinition(
 	},
 	[]key.Action{
 		key.ActionEnter,
+		key.ActionMouse,
 	},
 )
 
@@ -47,6 +48,7 @@ var write_definition = screen.NewDefinition(
 		key.ActionArrowRight,
 		key.ActionArrowUp,
 		key.ActionArrowDown,
+		key.ActionMouse,
 	},
 )
 
@@ -178,6 +180,13 @@ func (n *CheckMenu) tickNavigation(uiState *state.UIState, event screen.Event) s
 	case key.ActionArrowDown:
 		optsLen = math.SubClampZero(optsLen, 1)
 		n.cursor = max(0, optsLen)
+	case key.ActionMouse:
+		if !n.clickCursor(event) {
+			break
+		}
+		n.switchState(n.cursor)
+		n.applyLimit()
+		n.tickToStack(uiState)
 	}
 
 	return screen.ResultFromUIState(uiState)
@@ -198,11 +207,28 @@ func (n *CheckMenu) tickRead(uiState *state.UIState, event screen.Event) screen.
 	switch ky.Code {
 	case key.ActionEnter:
 		n.action.ActionMode = true
+	case key.ActionMouse:
+		if n.clickCursor(event) {
+			n.action.ActionMode = true
+		}
 	}
 
 	return screen.ResultFromUIState(uiState)
 }
 
+func (n *CheckMenu) clickCursor(event screen.Event) bool {
+	if !event.IsClickOn(n.reference) {
+		return false
+	}
+
+	if event.Hit.Index >= uint16(len(n.options)) {
+		return false
+	}
+
+	n.cursor = event.Hit.Index
+	return true
+}
+
 func (n *CheckMenu) switchState(cursor uint16) *CheckMenu {
 	if cursor >= uint16(len(n.options)) {
 		return n
@@ -257,6 +283,7 @@ func (n *CheckMenu) activeIds() set.Set[string] {
 
 func (n *CheckMenu) view(_ state.UIState) viewmodel.ViewModel {
 	indexmenu := checkmenu.New(n.options).
+		Target(n.reference).
 		WriteMode(n.action.ActionMode).
 		Meta(n.meta).
 		Cursor(n.cursor)
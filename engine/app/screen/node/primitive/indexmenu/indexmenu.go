@@ -26,6 +26,7 @@ var index_menu_definition = screen.DefinitionFromActions(
 		key.ActionArrowUp,
 		key.ActionArrowDown,
 		key.CustomActionPointer,
+		key.ActionMouse,
 	}...,
 )
 
@@ -109,7 +110,7 @@ func (n *IndexMenu) tick(uiState *state.UIState, event screen.Event) screen.Resu
 	}
 
 	switch event.Key.Code {
-	case key.ActionArrowUp:
+	case key.ActionBackTab, key.ActionArrowUp:
 		n.cursor = (n.cursor + size - 1) % size
 		n.tickToStack(uiState)
 	case key.ActionTab, key.ActionArrowDown:
@@ -120,11 +121,32 @@ func (n *IndexMenu) tick(uiState *state.UIState, event screen.Event) screen.Resu
 		return n.actionEnter()
 	case key.CustomActionPointer:
 		n.pointer = indexmenu.NextPointer(n.pointer)
+	case key.ActionMouse:
+		return n.tickMouse(uiState, event)
 	}
 
 	return screen.EmptyResult()
 }
 
+func (n *IndexMenu) tickMouse(uiState *state.UIState, event screen.Event) screen.Result {
+	if !event.IsClickOn(n.reference) {
+		return screen.EmptyResult()
+	}
+
+	if event.Hit.Index >= uint16(len(n.options)) {
+		return screen.EmptyResult()
+	}
+
+	if n.cursor != event.Hit.Index {
+		n.cursor = event.Hit.Index
+		n.tickToStack(uiState)
+		return screen.EmptyResult()
+	}
+
+	n.tickToStack(uiState)
+	return n.actionEnter()
+}
+
 func (n *IndexMenu) tickToStack(uiState *state.UIState) {
 	if n.cursor >= uint16(len(n.options)) {
 		uiState.Stack.RemoveArgument(
@@ -153,6 +175,7 @@ func (n *IndexMenu) view(_ state.UIState) viewmodel.ViewModel {
 	pointer := indexmenu.FindPointer(n.pointer)
 
 	indexmenu := indexmenu.New(frags).
+		Target(n.reference).
 		Pointer(pointer).
 		Meta(n.meta).
 		Cursor(n.cursor)
@@ -8,8 +8,10 @@ import (
 	"github.com/Rafael24595/go-reacterm-core/engine/app/pager"
 	"github.com/Rafael24595/go-reacterm-core/engine/app/screen"
 	"github.com/Rafael24595/go-reacterm-core/engine/app/state"
+	"github.com/Rafael24595/go-reacterm-core/engine/model/hit"
 	"github.com/Rafael24595/go-reacterm-core/engine/model/input"
 	"github.com/Rafael24595/go-reacterm-core/engine/model/key"
+	"github.com/Rafael24595/go-reacterm-core/engine/model/mouse"
 	"github.com/Rafael24595/go-reacterm-core/engine/model/winsize"
 	"github.com/Rafael24595/go-reacterm-core/engine/render/text"
 
@@ -212,3 +214,57 @@ func TestIndexMenu_ViewCursor(t *testing.T) {
 	assert.Equal(t, "- A", text.LineToString(&lines[0]))
 	assert.Equal(t, "> B", text.LineToString(&lines[1]))
 }
+
+func TestIndexMenu_MouseClick(t *testing.T) {
+	expected := screen.Node{
+		Name:   "next",
+		Screen: screen.Screen{},
+	}
+
+	menu := New().
+		AddOptions(
+			input.NewMenuOption(
+				"opt_a",
+				*text.NewFragment("A"),
+				voidAction,
+			),
+			input.NewMenuOption(
+				"opt_b",
+				*text.NewFragment("B"),
+				func() screen.Node { return expected },
+			),
+		)
+
+	node := menu.ToNode()
+
+	click := screen.NewMouseEvent(
+		*mouse.New(mouse.KindPress, mouse.ButtonLeft, 1, 0),
+		hit.New(Name, 1),
+	)
+
+	result := node.Screen.Tick(state.NewUIState(), click)
+	assert.Equal(t, menu.cursor, 1)
+	assert.Nil(t, result.Node)
+
+	result = node.Screen.Tick(state.NewUIState(), click)
+	assert.NotNil(t, result.Node)
+	assert.Equal(t, result.Node.Name, "next")
+}
+
+func TestIndexMenu_MouseClick_OtherOwner(t *testing.T) {
+	menu := New().
+		AddOptions(
+			input.MenuOption{Id: "a"},
+			input.MenuOption{Id: "b"},
+		)
+
+	node := menu.ToNode()
+
+	click := screen.NewMouseEvent(
+		*mouse.New(mouse.KindPress, mouse.ButtonLeft, 1, 0),
+		hit.New("other", 1),
+	)
+
+	node.Screen.Tick(state.NewUIState(), click)
+	assert.Equal(t, menu.cursor, 0)
+}
@@ -5,6 +5,7 @@ import (
 	"github.com/Rafael24595/go-reacterm-core/engine/app/state"
 	"github.com/Rafael24595/go-reacterm-core/engine/app/viewmodel"
 	"github.com/Rafael24595/go-reacterm-core/engine/helper/math"
+	"github.com/Rafael24595/go-reacterm-core/engine/layout/drawable/spatial/zstack"
 	"github.com/Rafael24595/go-reacterm-core/engine/layout/drawable/widget/modal"
 	"github.com/Rafael24595/go-reacterm-core/engine/model/input"
 	"github.com/Rafael24595/go-reacterm-core/engine/model/key"
@@ -35,6 +36,7 @@ type ModalMenu struct {
 	text      []text.Line
 	options   []input.MenuOption
 	cursor    uint16
+	base      *screen.Node
 }
 
 func New() *ModalMenu {
@@ -43,6 +45,7 @@ func New() *ModalMenu {
 		text:      make([]text.Line, 0),
 		options:   make([]input.MenuOption, 0),
 		cursor:    0,
+		base:      nil,
 	}
 }
 
@@ -67,18 +70,34 @@ func (n *ModalMenu) SetCursor(cursor uint16) *ModalMenu {
 	return n
 }
 
+// Over floats the modal above the view of node, dimmed behind it,
+// instead of replacing it. The modal keeps every key to itself.
+func (n *ModalMenu) Over(node screen.Node) *ModalMenu {
+	n.base = &node
+	return n
+}
+
 func (n *ModalMenu) ToNode() screen.Node {
-	return screen.NewBuilder().
+	builder := screen.NewBuilder().
 		Name(n.reference).
 		NameToStack().
 		Init(n.init).
 		Keys(n.keys).
 		Tick(n.tick).
-		View(n.view).
-		ToNode()
+		View(n.view)
+
+	if n.base != nil {
+		builder.Children(*n.base)
+	}
+
+	return builder.ToNode()
 }
 
 func (n *ModalMenu) init(uiState state.UIState) {
+	if n.base != nil {
+		n.base.Screen.Init(uiState)
+	}
+
 	option, ok := state.FindParam(
 		uiState.Stack,
 		n.reference,
@@ -147,18 +166,24 @@ func (n *ModalMenu) actionEnter() screen.Result {
 	return screen.ResultFromNode(&node)
 }
 
-func (n *ModalMenu) view(_ state.UIState) viewmodel.ViewModel {
-	vm := viewmodel.New()
-
+func (n *ModalMenu) view(uiState state.UIState) viewmodel.ViewModel {
 	frags := input.FragmentFromMenuOption(n.options...)
 
 	modal := modal.New().
 		AddText(n.text...).
 		AddOptions(frags...).
-		DefineCursor(n.cursor).
-		ToUnit()
+		DefineCursor(n.cursor)
+
+	if n.base != nil {
+		vm := n.base.Screen.View(uiState)
+		layer := zstack.NewLayer(modal.Floating().ToUnit()).Dim()
+		vm.PushOverlay(layer)
+		return vm
+	}
+
+	vm := viewmodel.New()
 
-	vm.Kernel.Push(modal)
+	vm.Kernel.Push(modal.ToUnit())
 
 	return *vm
 }
@@ -5,8 +5,10 @@ import (
 
 	assert "github.com/Rafael24595/go-assert/assert/test"
 
+	"github.com/Rafael24595/go-reacterm-core/engine/app/screen/node/primitive/article"
 	"github.com/Rafael24595/go-reacterm-core/engine/app/state"
 	"github.com/Rafael24595/go-reacterm-core/engine/model/input"
+	"github.com/Rafael24595/go-reacterm-core/engine/render/text"
 	screen_test "github.com/Rafael24595/go-reacterm-core/test/engine/app/screen"
 )
 
@@ -46,3 +48,35 @@ func TestModalMenu_Stack(t *testing.T) {
 
 	assert.True(t, stack.Has(Name))
 }
+
+func TestModalMenu_Over(t *testing.T) {
+	base := article.New().
+		AddArticle(*text.NewLine("Body")).
+		ToNode()
+
+	menu := New().
+		AddText(*text.NewLine("Sure?")).
+		Over(base)
+
+	node := menu.ToNode()
+	uiState := state.NewUIState()
+
+	node.Screen.Init(*uiState)
+	vm := node.Screen.View(*uiState)
+
+	assert.Len(t, 1, vm.Kernel.Units())
+	assert.Len(t, 1, vm.Overlays)
+	assert.Len(t, 1, node.Children())
+}
+
+func TestModalMenu_WithoutBase(t *testing.T) {
+	node := New().ToNode()
+	uiState := state.NewUIState()
+
+	node.Screen.Init(*uiState)
+	vm := node.Screen.View(*uiState)
+
+	assert.Len(t, 1, vm.Kernel.Units())
+	assert.Len(t, 0, vm.Overlays)
+	assert.Len(t, 0, node.Children())
+}
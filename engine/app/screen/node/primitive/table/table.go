@@ -29,6 +29,7 @@ var read_definition = screen.NewDefinition(
 	},
 	[]key.Action{
 		key.ActionEnter,
+		key.ActionMouse,
 	},
 )
 
@@ -43,6 +44,7 @@ var write_definition = screen.NewDefinition(
 		key.ActionArrowRight,
 		key.ActionArrowUp,
 		key.ActionArrowDown,
+		key.ActionMouse,
 	},
 )
 
@@ -110,6 +112,9 @@ func (n *Table[T]) AddItems(marshal MarshalFunc[T], items ...T) *Table[T] {
 		index := rows + uint16(i)
 		for _, field := range marshal(item) {
 			n.table.SetCell(field.Header, index, field.Value)
+			if field.Link != "" {
+				n.table.SetLink(field.Header, index, field.Link)
+			}
 		}
 	}
 	return n
@@ -189,6 +194,10 @@ func (n *Table[T]) tickeNavigation(uiState *state.UIState, event screen.Event) s
 			math.SubClampZero(n.table.Rows(), 1),
 		)
 		n.tickToStack(uiState)
+	case key.ActionMouse:
+		if n.clickCursor(event) {
+			n.tickToStack(uiState)
+		}
 	}
 
 	return screen.ResultFromUIState(uiState)
@@ -201,11 +210,31 @@ func (n *Table[T]) tickRead(uiState *state.UIState, event screen.Event) screen.R
 	case key.ActionEnter:
 		n.action.ActionMode = true
 		n.cursor.Show = n.action.ActionMode
+	case key.ActionMouse:
+		if !n.clickCursor(event) {
+			break
+		}
+		n.action.ActionMode = true
+		n.cursor.Show = n.action.ActionMode
+		n.tickToStack(uiState)
 	}
 
 	return screen.ResultFromUIState(uiState)
 }
 
+func (n *Table[T]) clickCursor(event screen.Event) bool {
+	if !event.IsClickOn(n.reference) {
+		return false
+	}
+
+	if event.Hit.Index >= n.table.Rows() {
+		return false
+	}
+
+	n.cursor.Row = event.Hit.Index
+	return true
+}
+
 func (n *Table[T]) tickToStack(uiState *state.UIState) {
 	tableState := State{
 		Row: n.cursor.Row,
@@ -223,7 +252,9 @@ func (n *Table[T]) tickToStack(uiState *state.UIState) {
 func (n *Table[T]) view(_ state.UIState) viewmodel.ViewModel {
 	vm := viewmodel.New()
 
-	table := drawable_table.UnitFromTable(*n.table, *n.cursor)
+	table := drawable_table.New(*n.table, *n.cursor).
+		Target(n.reference).
+		ToUnit()
 
 	position := padding.NewBuilder().
 		Y(hint.Maximize[winsize.Rows](), rows.WithPosition(n.positionY)).
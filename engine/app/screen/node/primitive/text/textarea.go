@@ -54,6 +54,7 @@ var area_write_definition = screen.NewDefinition(
 		key.CustomActionCut,
 		key.CustomActionCopy,
 		key.CustomActionPaste,
+		key.ActionPaste,
 		key.ActionRune,
 	},
 )
@@ -228,6 +229,11 @@ func (n *TextArea) tickWrite(uiState *state.UIState, event screen.Event) screen.
 		n.tickToStack(uiState)
 		return result
 
+	case key.ActionPaste:
+		result := n.pasteText(uiState, []rune(event.Paste))
+		n.tickToStack(uiState)
+		return result
+
 	case key.ActionEnter:
 		ky = *key.NewKeyRune(ascii.ENTER_LF)
 	}
@@ -321,7 +327,7 @@ func (n *TextArea) copyCut(uiState *state.UIState, cut bool) screen.Result {
 		return result
 	}
 
-	start := n.caret.SelectStart().Sub(1)
+	start := runes.PrevGrapheme(n.buffer.Buffer(), n.caret.SelectStart())
 	end := n.caret.SelectEnd()
 
 	n.clipboard.Put(n.buffer.Range(start, end))
@@ -336,9 +342,13 @@ func (n *TextArea) copyCut(uiState *state.UIState, cut bool) screen.Result {
 }
 
 func (n *TextArea) paste(uiState *state.UIState) screen.Result {
+	return n.pasteText(uiState, n.clipboard.Buffer())
+}
+
+func (n *TextArea) pasteText(uiState *state.UIState, text []rune) screen.Result {
 	start, end, fixEnd := n.insertSelection()
 
-	insert, delete := n.buffer.Replace(n.clipboard.Buffer(), start, end)
+	insert, delete := n.buffer.Replace(text, start, end)
 	n.history.PushEvent(event.Paste, start, fixEnd, string(delete), string(insert))
 
 	position := start + offset.Offset(len(insert))
@@ -460,14 +470,14 @@ func (n *TextArea) moveBackward(uiState *state.UIState, event screen.Event) scre
 	buffer := n.buffer.Buffer()
 
 	if event.Key.Mod.HasNone(key.ModShift, key.ModCtrl) {
-		caret := n.caret.Caret().Sub(1)
+		caret := n.caret.Prev(buffer)
 		n.caret.MoveCaretTo(buffer, caret)
 		return result
 	}
 
 	anchor := n.caret.Anchor()
 	if event.Key.Mod.HasNone(key.ModCtrl) {
-		caret := n.caret.Caret().Sub(1)
+		caret := n.caret.Prev(buffer)
 		n.caret.MoveSelectTo(buffer, caret, anchor)
 		return result
 	}
@@ -489,14 +499,14 @@ func (n *TextArea) moveForward(uiState *state.UIState, event screen.Event) scree
 	size := n.buffer.Size()
 
 	if event.Key.Mod.HasNone(key.ModShift, key.ModCtrl) {
-		caret := min(size, n.caret.Caret()+1)
+		caret := min(size, n.caret.Next(buffer))
 		n.caret.MoveCaretTo(buffer, caret)
 		return result
 	}
 
 	anchor := n.caret.Anchor()
 	if event.Key.Mod.HasNone(key.ModCtrl) {
-		caret := min(size, n.caret.Caret()+1)
+		caret := min(size, n.caret.Next(buffer))
 		n.caret.MoveSelectTo(buffer, caret, anchor)
 		return result
 	}
@@ -523,7 +533,7 @@ func (n *TextArea) deleteBackward(uiState *state.UIState, word bool) screen.Resu
 	if word {
 		start = runes.BackwardIndex(n.buffer.Buffer(), runes.NextWordRunes, start)
 	} else {
-		start = start.Sub(1)
+		start = runes.PrevGrapheme(n.buffer.Buffer(), start)
 	}
 
 	end := n.caret.SelectEnd()
@@ -547,10 +557,10 @@ func (n *TextArea) deleteForward(uiState *state.UIState, word bool) screen.Resul
 	if word {
 		end = runes.ForwardIndex(n.buffer.Buffer(), runes.NextWordRunes, end)
 	} else {
-		end = min(n.buffer.Size(), end+1)
+		end = min(n.buffer.Size(), runes.NextGrapheme(n.buffer.Buffer(), end))
 	}
 
-	start := n.caret.SelectStart().Sub(1)
+	start := runes.PrevGrapheme(n.buffer.Buffer(), n.caret.SelectStart())
 
 	delete := n.buffer.Delete(start, end)
 	n.history.PushEvent(event.DeleteForward, start, end, string(delete), "")
@@ -602,7 +612,7 @@ func (n *TextArea) insertSelection() (offset.Offset, offset.Offset, offset.Offse
 	end := n.caret.SelectEnd()
 
 	if start != end {
-		return start.Sub(1), end, end + 1
+		return runes.PrevGrapheme(n.buffer.Buffer(), start), end, end + 1
 	}
 
 	return start, end, end
@@ -5,7 +5,9 @@ import (
 
 	assert "github.com/Rafael24595/go-assert/assert/test"
 
+	"github.com/Rafael24595/go-reacterm-core/engine/app/screen"
 	"github.com/Rafael24595/go-reacterm-core/engine/app/state"
+	"github.com/Rafael24595/go-reacterm-core/engine/model/key"
 	screen_test "github.com/Rafael24595/go-reacterm-core/test/engine/app/screen"
 )
 
@@ -45,3 +47,19 @@ func TestTextArea_Stack(t *testing.T) {
 
 	assert.True(t, stack.Has(NameArea))
 }
+
+func TestTextArea_PasteEvent(t *testing.T) {
+	area := NewArea().WriteMode()
+	node := area.ToNode()
+
+	uiState := state.NewUIState()
+
+	node.Screen.Tick(uiState, screen.NewPasteEvent("fn(a) {\n\treturn a\n}"))
+
+	assert.Equal(t, "fn(a) {\n\treturn a\n}", string(area.buffer.Buffer()))
+	assert.Equal(t, area.buffer.Size(), area.caret.Caret())
+
+	node.Screen.Tick(uiState, screen.NewEvent(*key.NewKeyCode(key.CustomActionUndo)))
+
+	assert.Equal(t, "", string(area.buffer.Buffer()))
+}
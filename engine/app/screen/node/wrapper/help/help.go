@@ -67,8 +67,13 @@ func (n *Help) view(uiState state.UIState) viewmodel.ViewModel {
 
 	definition := n.node.Screen.Keys()
 
+	fields := make([]key.Descriptor, 0, definition.Descriptor.Size())
+	for action, descriptor := range definition.Descriptor.All() {
+		fields = append(fields, descriptor.Bound(action))
+	}
+
 	vm.Footer.Push(
-		help.UnitFromFields(definition.Descriptor.ToValuesSlice()),
+		help.UnitFromFields(fields),
 	)
 
 	return vm
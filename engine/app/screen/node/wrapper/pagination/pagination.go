@@ -19,12 +19,16 @@ const errf_unhandled = "unhandled pager type '%d'"
 
 var base_definition = screen.NewDefinition(
 	map[key.Action]key.Descriptor{
-		key.ActionPageUp:   {Code: []string{"⇞"}, Detail: "Prev page"},
-		key.ActionPageDown: {Code: []string{"⇟"}, Detail: "Next page"},
+		key.ActionPageUp:    {Code: []string{"⇞"}, Detail: "Prev page"},
+		key.ActionPageDown:  {Code: []string{"⇟"}, Detail: "Next page"},
+		key.ActionWheelUp:   {Code: []string{"WHEEL↑"}, Detail: "Prev page"},
+		key.ActionWheelDown: {Code: []string{"WHEEL↓"}, Detail: "Next page"},
 	},
 	[]key.Action{
 		key.ActionPageUp,
 		key.ActionPageDown,
+		key.ActionWheelUp,
+		key.ActionWheelDown,
 	},
 )
 
@@ -145,13 +149,12 @@ func (n *Pagination) localTick(uiState *state.UIState, event screen.Event) *scre
 
 	assert.True(ok, errf_unhandled, pager.CodeEnginePaged)
 
-	if event.Key.Code == key.ActionPageUp || event.Key.Code == keys.back {
+	switch event.Key.Code {
+	case key.ActionPageUp, key.ActionWheelUp, keys.back:
 		uiState.Pager.DecTarget()
 		result := screen.ResultFromUIState(uiState)
 		return &result
-	}
-
-	if event.Key.Code == key.ActionPageDown || event.Key.Code == keys.next {
+	case key.ActionPageDown, key.ActionWheelDown, keys.next:
 		uiState.Pager.IncTarget()
 		result := screen.ResultFromUIState(uiState)
 		return &result
@@ -178,7 +181,7 @@ func (n *Pagination) view(uiState state.UIState) viewmodel.ViewModel {
 		*text.NewLine(
 			fmt.Sprintf("%s: %d", label, uiState.Pager.ActualPage),
 			style.SpecFromKind(style.SpcKindPaddingRight),
-		),
+		).SetRole(style.RoleFooter),
 	}
 
 	vm.Footer.Unshift(
@@ -1,6 +1,9 @@
 package state
 
+import "github.com/Rafael24595/go-reacterm-core/engine/model/winsize"
+
 type UIState struct {
+	Size   winsize.Winsize
 	Helper HelperContext
 	Pager  PagerContext
 	Stack  *StackContext
@@ -8,6 +11,7 @@ type UIState struct {
 
 func NewUIState() *UIState {
 	return &UIState{
+		Size:   winsize.Winsize{},
 		Helper: HelperContext{},
 		Pager:  PagerContext{},
 		Stack:  newStackContext(),
@@ -3,6 +3,7 @@ package viewmodel
 import (
 	"github.com/Rafael24595/go-reacterm-core/engine/app/pager"
 	"github.com/Rafael24595/go-reacterm-core/engine/layout/drawable/spatial/stack"
+	"github.com/Rafael24595/go-reacterm-core/engine/layout/drawable/spatial/zstack"
 )
 
 // TODO: Use Screen and Units sources to manage Header and Footer.
@@ -10,6 +11,7 @@ type ViewModel struct {
 	Header   *stack.VStackUnit
 	Kernel   *stack.VStackUnit
 	Footer   *stack.VStackUnit
+	Overlays []zstack.Layer
 	Pager    *pager.PagerStrategy
 	Behavior BehaviorContext
 }
@@ -19,6 +21,7 @@ func New() *ViewModel {
 		Header:   stack.NewVStack(),
 		Kernel:   stack.NewVStack(),
 		Footer:   stack.NewVStack(),
+		Overlays: make([]zstack.Layer, 0),
 		Pager:    pager.NewStrategy(),
 		Behavior: BehaviorContext{},
 	}
@@ -30,7 +33,16 @@ func (v *ViewModel) Clone() *ViewModel {
 	vm.Header.Push(v.Header.Units()...)
 	vm.Kernel.Push(v.Kernel.Units()...)
 	vm.Footer.Push(v.Footer.Units()...)
+	vm.Overlays = append(vm.Overlays, v.Overlays...)
 	vm.Pager = v.Pager
 
 	return vm
 }
+
+// PushOverlay floats layers over the composed frame, the last on top.
+func (v *ViewModel) PushOverlay(layers ...*zstack.Layer) *ViewModel {
+	for _, layer := range layers {
+		v.Overlays = append(v.Overlays, *layer)
+	}
+	return v
+}
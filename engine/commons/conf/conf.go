@@ -0,0 +1,67 @@
+package conf
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+)
+
+type Format string
+
+const (
+	FormatJSON Format = "json"
+	FormatTOML Format = "toml"
+)
+
+func FormatOf(path string) Format {
+	return Format(strings.TrimPrefix(strings.ToLower(filepath.Ext(path)), "."))
+}
+
+func DecodeFile(path string) (map[string]any, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+
+	return Decode(data, FormatOf(path))
+}
+
+func Decode(data []byte, format Format) (map[string]any, error) {
+	switch format {
+	case FormatJSON:
+		values := make(map[string]any)
+		if err := json.Unmarshal(data, &values); err != nil {
+			return nil, err
+		}
+		return values, nil
+	case FormatTOML:
+		return decodeTOML(data)
+	}
+
+	return nil, fmt.Errorf("unsupported format %q", format)
+}
+
+func Strings(value any) ([]string, bool) {
+	switch v := value.(type) {
+	case string:
+		return []string{v}, true
+	case []any:
+		values := make([]string, len(v))
+		for i, item := range v {
+			str, ok := item.(string)
+			if !ok {
+				return nil, false
+			}
+			values[i] = str
+		}
+		return values, true
+	}
+	return nil, false
+}
+
+func Table(value any) (map[string]any, bool) {
+	table, ok := value.(map[string]any)
+	return table, ok
+}
@@ -0,0 +1,63 @@
+package conf
+
+import (
+	"testing"
+
+	assert "github.com/Rafael24595/go-assert/assert/test"
+)
+
+func TestDecode_TOML(t *testing.T) {
+	data := []byte(`
+# comment
+name = "brand" # trailing
+count = 3
+
+[roles.focus]
+fg = "#ff8800"
+atoms = ["bold", 'italic', "a,b"]
+
+[glyphs]
+"gutter.left" = ">"
+`)
+
+	values, err := Decode(data, FormatTOML)
+	assert.Nil(t, err)
+
+	assert.Equal(t, "brand", values["name"].(string))
+	assert.Equal(t, 3.0, values["count"].(float64))
+
+	roles, ok := Table(values["roles"])
+	assert.True(t, ok)
+
+	focus, ok := Table(roles["focus"])
+	assert.True(t, ok)
+	assert.Equal(t, "#ff8800", focus["fg"].(string))
+
+	atoms, ok := Strings(focus["atoms"])
+	assert.True(t, ok)
+	assert.DeepEqual(t, []string{"bold", "italic", "a,b"}, atoms)
+
+	glyphs, ok := Table(values["glyphs"])
+	assert.True(t, ok)
+	assert.Equal(t, ">", glyphs["gutter.left"].(string))
+}
+
+func TestDecode_TOMLErrors(t *testing.T) {
+	inputs := []string{
+		"name",
+		"name = \"a\"\nname = \"b\"",
+		"[table",
+		"value = [\"a\"",
+		"value = nope",
+	}
+
+	for _, input := range inputs {
+		_, err := Decode([]byte(input), FormatTOML)
+		assert.NotNil(t, err)
+	}
+}
+
+func TestDecode_Unsupported(t *testing.T) {
+	_, err := Decode([]byte(""), Format("yaml"))
+	assert.NotNil(t, err)
+}
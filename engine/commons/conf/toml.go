@@ -0,0 +1,179 @@
+package conf
+
+import (
+	"bufio"
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// decodeTOML understands the subset configuration files need: tables,
+// dotted table headers, strings, integers, booleans and single line
+// arrays of those. Values decode to the same shapes as encoding/json.
+func decodeTOML(data []byte) (map[string]any, error) {
+	root := make(map[string]any)
+	table := root
+
+	scanner := bufio.NewScanner(strings.NewReader(string(data)))
+	for line := 1; scanner.Scan(); line++ {
+		text := strings.TrimSpace(stripComment(scanner.Text()))
+		if text == "" {
+			continue
+		}
+
+		if strings.HasPrefix(text, "[") {
+			if !strings.HasSuffix(text, "]") {
+				return nil, fmt.Errorf("line %d: unterminated table header", line)
+			}
+
+			next, err := openTable(root, strings.Trim(text, "[]"))
+			if err != nil {
+				return nil, fmt.Errorf("line %d: %w", line, err)
+			}
+
+			table = next
+			continue
+		}
+
+		name, raw, ok := strings.Cut(text, "=")
+		if !ok {
+			return nil, fmt.Errorf("line %d: expected key = value", line)
+		}
+
+		key, err := parseKey(name)
+		if err != nil {
+			return nil, fmt.Errorf("line %d: %w", line, err)
+		}
+
+		if _, exists := table[key]; exists {
+			return nil, fmt.Errorf("line %d: duplicate key %q", line, key)
+		}
+
+		value, err := parseValue(strings.TrimSpace(raw))
+		if err != nil {
+			return nil, fmt.Errorf("line %d: %w", line, err)
+		}
+
+		table[key] = value
+	}
+
+	return root, scanner.Err()
+}
+
+func openTable(root map[string]any, header string) (map[string]any, error) {
+	table := root
+	for part := range strings.SplitSeq(header, ".") {
+		key, err := parseKey(part)
+		if err != nil {
+			return nil, err
+		}
+
+		next, exists := table[key]
+		if !exists {
+			next = make(map[string]any)
+			table[key] = next
+		}
+
+		nested, ok := next.(map[string]any)
+		if !ok {
+			return nil, fmt.Errorf("key %q is not a table", key)
+		}
+
+		table = nested
+	}
+
+	return table, nil
+}
+
+func parseKey(raw string) (string, error) {
+	key := strings.TrimSpace(raw)
+	if strings.HasPrefix(key, `"`) {
+		return strconv.Unquote(key)
+	}
+
+	if key == "" {
+		return "", fmt.Errorf("empty key")
+	}
+
+	return key, nil
+}
+
+func parseValue(raw string) (any, error) {
+	switch {
+	case strings.HasPrefix(raw, "["):
+		return parseArray(raw)
+	case strings.HasPrefix(raw, `"`):
+		return strconv.Unquote(raw)
+	case strings.HasPrefix(raw, "'"):
+		if len(raw) < 2 || !strings.HasSuffix(raw, "'") {
+			return nil, fmt.Errorf("invalid string %s", raw)
+		}
+		return raw[1 : len(raw)-1], nil
+	case raw == "true" || raw == "false":
+		return raw == "true", nil
+	}
+
+	number, err := strconv.ParseFloat(raw, 64)
+	if err != nil {
+		return nil, fmt.Errorf("invalid value %s", raw)
+	}
+
+	return number, nil
+}
+
+func parseArray(raw string) ([]any, error) {
+	if !strings.HasSuffix(raw, "]") {
+		return nil, fmt.Errorf("unterminated array %s", raw)
+	}
+
+	values := make([]any, 0)
+	for _, item := range splitArray(raw[1 : len(raw)-1]) {
+		item = strings.TrimSpace(item)
+		if item == "" {
+			continue
+		}
+
+		value, err := parseValue(item)
+		if err != nil {
+			return nil, err
+		}
+		values = append(values, value)
+	}
+
+	return values, nil
+}
+
+func splitArray(raw string) []string {
+	items := make([]string, 0)
+
+	quote := rune(0)
+	start := 0
+	for i, rn := range raw {
+		switch {
+		case quote != 0 && rn == quote:
+			quote = 0
+		case quote == 0 && (rn == '"' || rn == '\''):
+			quote = rn
+		case quote == 0 && rn == ',':
+			items = append(items, raw[start:i])
+			start = i + 1
+		}
+	}
+
+	return append(items, raw[start:])
+}
+
+func stripComment(line string) string {
+	quote := rune(0)
+	for i, rn := range line {
+		switch {
+		case quote != 0 && rn == quote:
+			quote = 0
+		case quote == 0 && (rn == '"' || rn == '\''):
+			quote = rn
+		case quote == 0 && rn == '#':
+			return line[:i]
+		}
+	}
+	return line
+}
@@ -0,0 +1,70 @@
+package breakpoint
+
+import "github.com/Rafael24595/go-reacterm-core/engine/model/winsize"
+
+// Breakpoint reports whether a layout applies to a terminal size.
+type Breakpoint func(size winsize.Winsize) bool
+
+func MinCols(cols winsize.Cols) Breakpoint {
+	return func(size winsize.Winsize) bool {
+		return size.Cols >= cols
+	}
+}
+
+func MaxCols(cols winsize.Cols) Breakpoint {
+	return func(size winsize.Winsize) bool {
+		return size.Cols <= cols
+	}
+}
+
+func MinRows(rows winsize.Rows) Breakpoint {
+	return func(size winsize.Winsize) bool {
+		return size.Rows >= rows
+	}
+}
+
+func MaxRows(rows winsize.Rows) Breakpoint {
+	return func(size winsize.Winsize) bool {
+		return size.Rows <= rows
+	}
+}
+
+// MinSize applies when the terminal is at least as large as min on
+// both axes.
+func MinSize(min winsize.Winsize) Breakpoint {
+	return All(MinRows(min.Rows), MinCols(min.Cols))
+}
+
+func All(breakpoints ...Breakpoint) Breakpoint {
+	return func(size winsize.Winsize) bool {
+		for _, b := range breakpoints {
+			if !b(size) {
+				return false
+			}
+		}
+		return true
+	}
+}
+
+type Case[T any] struct {
+	breakpoint Breakpoint
+	value      T
+}
+
+func When[T any](breakpoint Breakpoint, value T) Case[T] {
+	return Case[T]{
+		breakpoint: breakpoint,
+		value:      value,
+	}
+}
+
+// Pick returns the value of the first case matching size, with its
+// index, or fallback and -1 when none does.
+func Pick[T any](size winsize.Winsize, fallback T, cases ...Case[T]) (T, int) {
+	for i, c := range cases {
+		if c.breakpoint(size) {
+			return c.value, i
+		}
+	}
+	return fallback, -1
+}
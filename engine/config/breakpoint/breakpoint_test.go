@@ -0,0 +1,48 @@
+package breakpoint
+
+import (
+	"testing"
+
+	assert "github.com/Rafael24595/go-assert/assert/test"
+
+	"github.com/Rafael24595/go-reacterm-core/engine/model/winsize"
+)
+
+func TestBreakpoint_Bounds(t *testing.T) {
+	size := winsize.New(24, 100)
+
+	assert.True(t, MinCols(100)(size))
+	assert.False(t, MinCols(101)(size))
+	assert.True(t, MaxCols(100)(size))
+	assert.False(t, MaxCols(99)(size))
+
+	assert.True(t, MinRows(24)(size))
+	assert.False(t, MaxRows(23)(size))
+}
+
+func TestBreakpoint_MinSize(t *testing.T) {
+	min := MinSize(winsize.New(24, 80))
+
+	assert.True(t, min(winsize.New(24, 80)))
+	assert.False(t, min(winsize.New(23, 200)))
+	assert.False(t, min(winsize.New(50, 79)))
+}
+
+func TestPick_FirstMatchWins(t *testing.T) {
+	cases := []Case[string]{
+		When(MinCols(120), "wide"),
+		When(MinCols(80), "medium"),
+	}
+
+	value, index := Pick(winsize.New(10, 150), "narrow", cases...)
+	assert.Equal(t, "wide", value)
+	assert.Equal(t, 0, index)
+
+	value, index = Pick(winsize.New(10, 90), "narrow", cases...)
+	assert.Equal(t, "medium", value)
+	assert.Equal(t, 1, index)
+
+	value, index = Pick(winsize.New(10, 40), "narrow", cases...)
+	assert.Equal(t, "narrow", value)
+	assert.Equal(t, -1, index)
+}
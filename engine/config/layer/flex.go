@@ -0,0 +1,212 @@
+package layer
+
+import (
+	"github.com/Rafael24595/go-reacterm-core/engine/config/chunk"
+	"github.com/Rafael24595/go-reacterm-core/engine/helper/math"
+	"github.com/Rafael24595/go-reacterm-core/engine/layout/drawable"
+)
+
+// MeasureFunc tells the preferred size of a unit along the stack axis.
+type MeasureFunc[T math.Number] func(unit drawable.Unit) T
+
+type flex[T math.Number] struct {
+	min     *chunk.Chunk[T]
+	max     *chunk.Chunk[T]
+	grow    *uint16
+	shrink  *uint16
+	content bool
+}
+
+func (f flex[T]) declared() bool {
+	return f.min != nil || f.max != nil || f.grow != nil || f.shrink != nil || f.content
+}
+
+type flexItem struct {
+	basis  int
+	size   int
+	min    int
+	max    int
+	grow   int
+	shrink int
+	frozen bool
+}
+
+// Solve sizes the layers within total. Each layer starts at its basis:
+// its chunk when sized, the measure of its unit when sized by content,
+// or nothing. The free space left by the bases is then shared by grow
+// weight, or the missing space taken back by shrink weight. Min and max
+// clamp the shared size rather than the basis, so a layer with a min
+// only takes more than its share when the share falls short of it.
+// Unless told otherwise dynamic layers grow by one, content layers
+// shrink by one and sized layers do neither.
+func Solve[T math.Number](layers []Layer[T], total T, measure MeasureFunc[T]) []T {
+	items := make([]flexItem, len(layers))
+	for i, l := range layers {
+		items[i] = newFlexItem(l, total, measure)
+	}
+
+	growing := int(total) >= sumBasis(items)
+	for i := range items {
+		if items[i].weight(growing) == 0 {
+			items[i].size = items[i].clamp(items[i].basis)
+			items[i].frozen = true
+		}
+	}
+
+	for flexRound(items, int(total), growing) {
+	}
+
+	sizes := make([]T, len(items))
+
+	remaining := int(total)
+	for i, item := range items {
+		size := max(0, min(item.size, remaining))
+		sizes[i] = T(size)
+		remaining -= size
+	}
+
+	return sizes
+}
+
+func newFlexItem[T math.Number](l Layer[T], total T, measure MeasureFunc[T]) flexItem {
+	f := l.config.flex
+	chk := l.Chunk()
+
+	item := flexItem{
+		basis:  0,
+		size:   0,
+		min:    0,
+		max:    int(total),
+		grow:   0,
+		shrink: 0,
+	}
+
+	switch {
+	case f.content:
+		if measure != nil {
+			item.basis = int(measure(l.Unit()))
+		}
+		item.shrink = 1
+	case chk.Sized:
+		item.basis = int(chk.Adapter(total))
+	default:
+		item.grow = 1
+	}
+
+	if f.min != nil {
+		item.min = int(f.min.Adapter(total))
+	}
+
+	if f.max != nil {
+		item.max = int(f.max.Adapter(total))
+	}
+
+	if f.grow != nil {
+		item.grow = int(*f.grow)
+	}
+
+	if f.shrink != nil {
+		item.shrink = int(*f.shrink)
+	}
+
+	item.size = item.basis
+
+	return item
+}
+
+func sumBasis(items []flexItem) int {
+	total := 0
+	for _, item := range items {
+		total += item.basis
+	}
+	return total
+}
+
+func (i flexItem) weight(growing bool) int {
+	if growing {
+		return i.grow
+	}
+	return i.shrink
+}
+
+func (i flexItem) clamp(size int) int {
+	return max(i.min, min(size, i.max))
+}
+
+// flexRound shares the free space among the items that are not frozen
+// yet, starting again from their basis. When the shares break some
+// min or max the offending items are frozen at the bound and the rest
+// are shared again in the next round. It reports whether another round
+// is needed.
+func flexRound(items []flexItem, total int, growing bool) bool {
+	free := total
+	weights := make([]int, len(items))
+
+	sum := 0
+	for i, item := range items {
+		if item.frozen {
+			free -= item.size
+			continue
+		}
+
+		free -= item.basis
+		weights[i] = item.weight(growing)
+		sum += weights[i]
+	}
+
+	if sum == 0 {
+		return false
+	}
+
+	amount := math.Abs(free)
+
+	shares := make([]int, len(items))
+
+	given := 0
+	for i, weight := range weights {
+		shares[i] = amount * weight / sum
+		given += shares[i]
+	}
+
+	for i := 0; given < amount; i = (i + 1) % len(items) {
+		if weights[i] == 0 {
+			continue
+		}
+
+		shares[i] += 1
+		given += 1
+	}
+
+	violation := 0
+	targets := make([]int, len(items))
+	for i := range items {
+		if weights[i] == 0 {
+			continue
+		}
+
+		targets[i] = items[i].basis + shares[i]
+		if free < 0 {
+			targets[i] = items[i].basis - shares[i]
+		}
+
+		items[i].size = items[i].clamp(targets[i])
+		violation += items[i].size - targets[i]
+	}
+
+	for i := range items {
+		if weights[i] == 0 {
+			continue
+		}
+
+		switch {
+		case violation == 0:
+			items[i].frozen = true
+		case violation > 0 && items[i].size > targets[i]:
+			items[i].frozen = true
+		case violation < 0 && items[i].size < targets[i]:
+			items[i].frozen = true
+		}
+	}
+
+	return violation != 0
+}
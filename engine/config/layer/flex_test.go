@@ -0,0 +1,155 @@
+package layer
+
+import (
+	"testing"
+
+	assert "github.com/Rafael24595/go-assert/assert/test"
+
+	"github.com/Rafael24595/go-reacterm-core/engine/config/chunk"
+	"github.com/Rafael24595/go-reacterm-core/engine/layout/drawable"
+	"github.com/Rafael24595/go-reacterm-core/engine/model/winsize"
+
+	drawable_test "github.com/Rafael24595/go-reacterm-core/test/engine/layout/drawable"
+)
+
+func layers(opts ...[]Option[winsize.Cols]) []Layer[winsize.Cols] {
+	result := make([]Layer[winsize.Cols], len(opts))
+	for i, o := range opts {
+		mock := &drawable_test.MockUnit{}
+		result[i] = New(mock.ToUnit(), o...)
+	}
+	return result
+}
+
+func sidebar() []Option[winsize.Cols] {
+	return []Option[winsize.Cols]{
+		WithMin(chunk.Fixed[winsize.Cols](20)),
+		WithMax(chunk.Percent[winsize.Cols](30)),
+		Grow[winsize.Cols](0),
+	}
+}
+
+func TestSolve_DynamicShareEvenly(t *testing.T) {
+	sizes := Solve(layers(nil, nil, nil), 100, nil)
+
+	assert.Equal(t, 34, sizes[0])
+	assert.Equal(t, 33, sizes[1])
+	assert.Equal(t, 33, sizes[2])
+}
+
+func TestSolve_SidebarKeepsMin(t *testing.T) {
+	sizes := Solve(layers(sidebar(), nil), 50, nil)
+
+	assert.Equal(t, 20, sizes[0])
+	assert.Equal(t, 30, sizes[1])
+}
+
+func TestSolve_SidebarNeverGrows(t *testing.T) {
+	items := layers(
+		append(sidebar(), Fixed[winsize.Cols](25)),
+		nil,
+	)
+
+	sizes := Solve(items, 200, nil)
+
+	assert.Equal(t, 25, sizes[0])
+	assert.Equal(t, 175, sizes[1])
+}
+
+func TestSolve_SidebarCappedByMax(t *testing.T) {
+	items := layers(
+		append(sidebar(), Fixed[winsize.Cols](90)),
+		nil,
+	)
+
+	sizes := Solve(items, 200, nil)
+
+	assert.Equal(t, 60, sizes[0])
+	assert.Equal(t, 140, sizes[1])
+}
+
+func TestSolve_MaxHandsLeftoverToOthers(t *testing.T) {
+	sizes := Solve(layers([]Option[winsize.Cols]{Max[winsize.Cols](10)}, nil), 100, nil)
+
+	assert.Equal(t, 10, sizes[0])
+	assert.Equal(t, 90, sizes[1])
+}
+
+func TestSolve_GrowWeights(t *testing.T) {
+	items := layers(
+		[]Option[winsize.Cols]{Grow[winsize.Cols](1)},
+		[]Option[winsize.Cols]{Grow[winsize.Cols](3)},
+	)
+
+	sizes := Solve(items, 80, nil)
+
+	assert.Equal(t, 20, sizes[0])
+	assert.Equal(t, 60, sizes[1])
+}
+
+func TestSolve_ShrinkWeights(t *testing.T) {
+	items := layers(
+		[]Option[winsize.Cols]{Fixed[winsize.Cols](40), Shrink[winsize.Cols](1)},
+		[]Option[winsize.Cols]{Fixed[winsize.Cols](40), Shrink[winsize.Cols](3), Min[winsize.Cols](35)},
+		[]Option[winsize.Cols]{Fixed[winsize.Cols](40)},
+	)
+
+	sizes := Solve(items, 100, nil)
+
+	assert.Equal(t, 25, sizes[0])
+	assert.Equal(t, 35, sizes[1])
+	assert.Equal(t, 40, sizes[2])
+}
+
+func TestSolve_Content(t *testing.T) {
+	items := layers(
+		[]Option[winsize.Cols]{Content[winsize.Cols]()},
+		nil,
+	)
+
+	measure := func(drawable.Unit) winsize.Cols {
+		return 12
+	}
+
+	sizes := Solve(items, 50, measure)
+
+	assert.Equal(t, 12, sizes[0])
+	assert.Equal(t, 38, sizes[1])
+}
+
+func TestSolve_ClampsOverflow(t *testing.T) {
+	items := layers(
+		[]Option[winsize.Cols]{Fixed[winsize.Cols](8)},
+		[]Option[winsize.Cols]{Fixed[winsize.Cols](8)},
+	)
+
+	sizes := Solve(items, 10, nil)
+
+	assert.Equal(t, 8, sizes[0])
+	assert.Equal(t, 2, sizes[1])
+}
+
+func TestLayer_FlexibleIsNotAnemic(t *testing.T) {
+	mock := &drawable_test.MockUnit{}
+
+	plain := New[winsize.Cols](mock.ToUnit())
+	flexible := New(mock.ToUnit(), Min[winsize.Cols](5))
+
+	assert.True(t, plain.IsAnemic())
+	assert.False(t, flexible.IsAnemic())
+	assert.True(t, flexible.Sized())
+}
+
+func TestSolve_MinClampsShare(t *testing.T) {
+	sizes := Solve(layers([]Option[winsize.Cols]{Min[winsize.Cols](4)}, nil), 10, nil)
+
+	assert.Equal(t, 5, sizes[0])
+	assert.Equal(t, 5, sizes[1])
+}
+
+func TestSolve_MinRaisesShortShare(t *testing.T) {
+	sizes := Solve(layers([]Option[winsize.Cols]{Min[winsize.Cols](7)}, nil), 10, nil)
+
+	assert.Equal(t, 7, sizes[0])
+	assert.Equal(t, 3, sizes[1])
+}
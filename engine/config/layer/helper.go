@@ -12,3 +12,11 @@ func Fixed[T math.Number](chk T) Option[T] {
 func Percent[T math.Number](chk T) Option[T] {
 	return WithChunk(chunk.Percent(chk))
 }
+
+func Min[T math.Number](size T) Option[T] {
+	return WithMin(chunk.Fixed(size))
+}
+
+func Max[T math.Number](size T) Option[T] {
+	return WithMax(chunk.Fixed(size))
+}
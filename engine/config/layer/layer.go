@@ -11,6 +11,7 @@ type config[T math.Number] struct {
 	unit   drawable.Unit
 	chunk  chunk.Chunk[T]
 	static bool
+	flex   flex[T]
 }
 
 type Layer[T math.Number] struct {
@@ -41,7 +42,7 @@ func FromLayer[T math.Number](other Layer[T], opts ...Option[T]) Layer[T] {
 }
 
 func (l Layer[T]) IsAnemic() bool {
-	return l.Chunk().IsAnemic() && !l.config.static
+	return l.Chunk().IsAnemic() && !l.config.static && !l.IsFlexible()
 }
 
 func (l Layer[T]) Unit() drawable.Unit {
@@ -55,3 +56,15 @@ func (l Layer[T]) Chunk() chunk.Chunk[T] {
 func (l Layer[T]) Static() bool {
 	return l.config.static
 }
+
+// IsFlexible reports whether the layer declares any flex constraint,
+// which makes its size come from the solver instead of its chunk alone.
+func (l Layer[T]) IsFlexible() bool {
+	return l.config.flex.declared()
+}
+
+// Sized reports whether the layer has a size of its own, either from a
+// sized chunk or from the solver.
+func (l Layer[T]) Sized() bool {
+	return l.Chunk().Sized || l.IsFlexible()
+}
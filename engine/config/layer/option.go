@@ -13,6 +13,7 @@ func defaultConfig[T math.Number](unit drawable.Unit) Layer[T] {
 		unit:   unit,
 		chunk:  chunk.Dynamic[T](),
 		static: false,
+		flex:   flex[T]{},
 	}
 
 	return Layer[T]{
@@ -39,3 +40,39 @@ func Static[T math.Number]() Option[T] {
 		cfg.config.static = true
 	}
 }
+
+func WithMin[T math.Number](min chunk.Chunk[T]) Option[T] {
+	return func(cfg *Layer[T]) {
+		cfg.config.flex.min = &min
+	}
+}
+
+func WithMax[T math.Number](max chunk.Chunk[T]) Option[T] {
+	return func(cfg *Layer[T]) {
+		cfg.config.flex.max = &max
+	}
+}
+
+// Grow sets the share of the free space the layer takes, relative to
+// the other growing layers. A weight of zero keeps it from growing.
+func Grow[T math.Number](weight uint16) Option[T] {
+	return func(cfg *Layer[T]) {
+		cfg.config.flex.grow = &weight
+	}
+}
+
+// Shrink sets the share of the missing space the layer gives up when
+// the layers do not fit. A weight of zero keeps it from shrinking.
+func Shrink[T math.Number](weight uint16) Option[T] {
+	return func(cfg *Layer[T]) {
+		cfg.config.flex.shrink = &weight
+	}
+}
+
+// Content starts the layer at the preferred size of its unit, as told
+// by its measure, instead of its chunk.
+func Content[T math.Number]() Option[T] {
+	return func(cfg *Layer[T]) {
+		cfg.config.flex.content = true
+	}
+}
@@ -1,14 +1,16 @@
 package line
 
 import (
+	"github.com/Rafael24595/go-reacterm-core/engine/helper/runes"
 	"github.com/Rafael24595/go-reacterm-core/engine/model/ascii"
 	"github.com/Rafael24595/go-reacterm-core/engine/model/offset"
+	"github.com/Rafael24595/go-reacterm-core/engine/model/winsize"
 )
 
-func DistanceFromLF(buffer []rune, from offset.Offset) offset.Offset {
-	return from.Sub(
-		FindLineStart(buffer, from),
-	)
+// DistanceFromLF returns the cells between the start of the line and from.
+func DistanceFromLF(buffer []rune, from offset.Offset) winsize.Cols {
+	start := FindLineStart(buffer, from)
+	return runes.Measure(string(buffer[start:from]))
 }
 
 func FindLineStart(buffer []rune, from offset.Offset) offset.Offset {
@@ -54,13 +56,18 @@ func FindPrevLineStart(buf []rune, from offset.Offset) (offset.Offset, bool) {
 	return FindLineStart(buf, prevLineStart-1), true
 }
 
-func ClampToLine(buf []rune, lineStart, col offset.Offset) offset.Offset {
+// ClampToLine returns the first grapheme boundary of the line that
+// starts at lineStart that reaches col cells, or the line end.
+func ClampToLine(buf []rune, lineStart offset.Offset, col winsize.Cols) offset.Offset {
 	end := FindLineEnd(buf, lineStart)
-	lineLen := end - lineStart
 
-	if col > lineLen {
-		return end
+	width := winsize.Cols(0)
+	position := lineStart
+	for position < end && width < col {
+		next := runes.NextGrapheme(buf, position)
+		width += winsize.Cols(runes.GraphemeWidth(buf[position:next]))
+		position = next
 	}
 
-	return lineStart + col
+	return position
 }
@@ -0,0 +1,249 @@
+package runes
+
+import (
+	"unicode"
+
+	"github.com/Rafael24595/go-reacterm-core/engine/helper/math"
+	"github.com/Rafael24595/go-reacterm-core/engine/model/ascii"
+)
+
+const (
+	zeroWidthJoiner    = 0x200D
+	zeroWidthNonJoiner = 0x200C
+	textPresentation   = 0xFE0E
+	emojiPresentation  = 0xFE0F
+)
+
+type graphemeClass uint8
+
+const (
+	gcOther graphemeClass = iota
+	gcCR
+	gcLF
+	gcControl
+	gcExtend
+	gcZWJ
+	gcSpacingMark
+	gcRegional
+	gcPictographic
+	gcHangulL
+	gcHangulV
+	gcHangulT
+	gcHangulLV
+	gcHangulLVT
+)
+
+func classOf(r rune) graphemeClass {
+	switch {
+	case r == '\r':
+		return gcCR
+	case r == '\n':
+		return gcLF
+	case r == zeroWidthJoiner:
+		return gcZWJ
+	case isExtend(r):
+		return gcExtend
+	case unicode.Is(unicode.Mc, r):
+		return gcSpacingMark
+	case isControl(r):
+		return gcControl
+	case r >= 0x1F1E6 && r <= 0x1F1FF:
+		return gcRegional
+	}
+
+	if class, ok := hangulClass(r); ok {
+		return class
+	}
+
+	if isPictographic(r) {
+		return gcPictographic
+	}
+
+	return gcOther
+}
+
+func isExtend(r rune) bool {
+	return unicode.In(r, unicode.Mn, unicode.Me) ||
+		r == zeroWidthNonJoiner ||
+		(r >= 0xFF9E && r <= 0xFF9F) ||
+		(r >= 0x1F3FB && r <= 0x1F3FF) ||
+		(r >= 0xE0020 && r <= 0xE007F)
+}
+
+func isControl(r rune) bool {
+	return unicode.In(r, unicode.Cc, unicode.Zl, unicode.Zp, unicode.Cf)
+}
+
+func hangulClass(r rune) (graphemeClass, bool) {
+	switch {
+	case (r >= 0x1100 && r <= 0x115F) || (r >= 0xA960 && r <= 0xA97C):
+		return gcHangulL, true
+	case (r >= 0x1160 && r <= 0x11A7) || (r >= 0xD7B0 && r <= 0xD7C6):
+		return gcHangulV, true
+	case (r >= 0x11A8 && r <= 0x11FF) || (r >= 0xD7CB && r <= 0xD7FB):
+		return gcHangulT, true
+	case r >= 0xAC00 && r <= 0xD7A3:
+		if (r-0xAC00)%28 == 0 {
+			return gcHangulLV, true
+		}
+		return gcHangulLVT, true
+	}
+	return gcOther, false
+}
+
+func isPictographic(r rune) bool {
+	switch {
+	case r == 0x00A9, r == 0x00AE, r == 0x203C, r == 0x2049,
+		r == 0x2122, r == 0x2139, r == 0x3030, r == 0x303D,
+		r == 0x3297, r == 0x3299:
+		return true
+	case r >= 0x2194 && r <= 0x21AA,
+		r >= 0x2300 && r <= 0x23FF,
+		r >= 0x25AA && r <= 0x25FE,
+		r >= 0x2600 && r <= 0x27BF,
+		r >= 0x2934 && r <= 0x2935,
+		r >= 0x2B05 && r <= 0x2B55,
+		r >= 0x1F000 && r <= 0x1F0FF,
+		r >= 0x1F10D && r <= 0x1F1AD,
+		r >= 0x1F201 && r <= 0x1F3FA,
+		r >= 0x1F400 && r <= 0x1FAFF,
+		r >= 0x1FC00 && r <= 0x1FFFD:
+		return true
+	}
+	return false
+}
+
+// graphemeSequence tracks the state that spans more than two runes:
+// emoji ZWJ sequences and regional indicator pairs.
+type graphemeSequence struct {
+	pictographic bool
+	joined       bool
+	regional     int
+}
+
+func (s *graphemeSequence) push(class graphemeClass) {
+	switch class {
+	case gcExtend:
+	case gcZWJ:
+		s.joined = s.pictographic
+		s.pictographic = false
+	case gcPictographic:
+		s.pictographic = true
+		s.joined = false
+	default:
+		s.pictographic = false
+		s.joined = false
+	}
+
+	if class == gcRegional {
+		s.regional++
+	} else {
+		s.regional = 0
+	}
+}
+
+func (s graphemeSequence) joins(prev, next graphemeClass) bool {
+	switch {
+	case prev == gcCR && next == gcLF:
+		return true
+	case prev == gcCR, prev == gcLF, prev == gcControl:
+		return false
+	case next == gcCR, next == gcLF, next == gcControl:
+		return false
+	case prev == gcHangulL:
+		return next == gcHangulL || next == gcHangulV ||
+			next == gcHangulLV || next == gcHangulLVT ||
+			next == gcExtend || next == gcZWJ || next == gcSpacingMark
+	case (prev == gcHangulLV || prev == gcHangulV) && (next == gcHangulV || next == gcHangulT):
+		return true
+	case (prev == gcHangulLVT || prev == gcHangulT) && next == gcHangulT:
+		return true
+	case next == gcExtend, next == gcZWJ, next == gcSpacingMark:
+		return true
+	case prev == gcZWJ && next == gcPictographic:
+		return s.joined
+	case prev == gcRegional && next == gcRegional:
+		return s.regional%2 == 1
+	}
+	return false
+}
+
+// graphemeEnd returns the index right after the grapheme cluster that
+// starts at start, following the extended cluster rules of UAX #29.
+func graphemeEnd(buffer []rune, start int) int {
+	if start >= len(buffer) {
+		return len(buffer)
+	}
+
+	prev := classOf(buffer[start])
+
+	seq := graphemeSequence{}
+	seq.push(prev)
+
+	for i := start + 1; i < len(buffer); i++ {
+		next := classOf(buffer[i])
+		if !seq.joins(prev, next) {
+			return i
+		}
+
+		seq.push(next)
+		prev = next
+	}
+
+	return len(buffer)
+}
+
+// NextGrapheme returns the offset right after the grapheme cluster that
+// starts at index.
+func NextGrapheme[T math.Number](buffer []rune, index T) T {
+	return T(graphemeEnd(buffer, int(index)))
+}
+
+// PrevGrapheme returns the offset where the grapheme cluster ending at
+// index starts. Line feeds always break, so the scan starts at the
+// beginning of the line that holds index.
+func PrevGrapheme[T math.Number](buffer []rune, index T) T {
+	end := min(int(index), len(buffer))
+	if end == 0 {
+		return 0
+	}
+
+	start := end - 1
+	for start > 0 && buffer[start-1] != ascii.ENTER_LF {
+		start--
+	}
+
+	for start < end {
+		next := graphemeEnd(buffer, start)
+		if next >= end {
+			return T(start)
+		}
+		start = next
+	}
+
+	return T(start)
+}
+
+// SnapGrapheme moves index forward to the closest grapheme boundary.
+func SnapGrapheme[T math.Number](buffer []rune, index T) T {
+	end := min(int(index), len(buffer))
+	if end == 0 {
+		return index
+	}
+
+	start := PrevGrapheme(buffer, end)
+	return T(graphemeEnd(buffer, start))
+}
+
+func Graphemes(text string) []string {
+	rns := []rune(text)
+
+	clusters := make([]string, 0, len(rns))
+	for i := 0; i < len(rns); {
+		end := graphemeEnd(rns, i)
+		clusters = append(clusters, string(rns[i:end]))
+		i = end
+	}
+
+	return clusters
+}
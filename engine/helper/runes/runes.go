@@ -244,14 +244,100 @@ func RuneIndexToByteIndex(text string, index offset.Offset) (offset.Offset, bool
 
 }
 
+// Measure returns the terminal cells text takes once printed.
 func Measure(text string) winsize.Cols {
-	return winsize.Cols(measure(text))
+	return winsize.Cols(width([]rune(text)))
 }
 
+// Measureo returns the runes of text, the unit buffer offsets count in.
 func Measureo(text string) offset.Offset {
-	return offset.Offset(measure(text))
+	return offset.Offset(utf8.RuneCountInString(text))
 }
 
-func measure(text string) int {
-	return utf8.RuneCountInString(text)
+// MeasureVisible returns the cells of text that reach the screen,
+// skipping CSI and OSC escape sequences.
+func MeasureVisible(text string) winsize.Cols {
+	rns := []rune(text)
+
+	visible := make([]rune, 0, len(rns))
+	for i := 0; i < len(rns); i++ {
+		if rns[i] != ascii.ESC {
+			visible = append(visible, rns[i])
+			continue
+		}
+
+		i = skipEscape(rns, i)
+	}
+
+	return winsize.Cols(width(visible))
+}
+
+// CutWidth splits text after the longest run of whole grapheme clusters
+// that fits in cols cells.
+func CutWidth(text string, cols winsize.Cols) (string, string) {
+	rns := []rune(text)
+
+	used := 0
+	end := 0
+	for end < len(rns) {
+		next := graphemeEnd(rns, end)
+		size := GraphemeWidth(rns[end:next])
+		if used+size > int(cols) {
+			break
+		}
+
+		used += size
+		end = next
+	}
+
+	return string(rns[:end]), string(rns[end:])
+}
+
+// CutWidthRight splits text before the longest run of whole grapheme
+// clusters at its end that fits in cols cells.
+func CutWidthRight(text string, cols winsize.Cols) (string, string) {
+	rns := []rune(text)
+
+	used := 0
+	start := len(rns)
+	for start > 0 {
+		prev := PrevGrapheme(rns, start)
+		size := GraphemeWidth(rns[prev:start])
+		if used+size > int(cols) {
+			break
+		}
+
+		used += size
+		start = prev
+	}
+
+	return string(rns[:start]), string(rns[start:])
+}
+
+func skipEscape(rns []rune, start int) int {
+	if start+1 >= len(rns) {
+		return start
+	}
+
+	switch rns[start+1] {
+	case '[':
+		for i := start + 2; i < len(rns); i++ {
+			if rns[i] >= 0x40 && rns[i] <= 0x7e {
+				return i
+			}
+		}
+	case ']':
+		for i := start + 2; i < len(rns); i++ {
+			if rns[i] == ascii.BEL {
+				return i
+			}
+			if rns[i] == ascii.ESC && i+1 < len(rns) && rns[i+1] == '\\' {
+				return i + 1
+			}
+		}
+	default:
+		return start + 1
+	}
+
+	return len(rns)
 }
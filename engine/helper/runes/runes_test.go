@@ -126,8 +126,8 @@ func TestMeasure(t *testing.T) {
 		want winsize.Cols
 	}{
 		{"ascii", "hello", 5},
-		{"unicode", "🙂🙂", 2},
-		{"mixed", "a🙂b", 3},
+		{"unicode", "🙂🙂", 4},
+		{"mixed", "a🙂b", 4},
 		{"empty", "", 0},
 	}
 
@@ -145,7 +145,7 @@ func TestMeasureu(t *testing.T) {
 		want winsize.Cols
 	}{
 		{"ascii", "hello", 5},
-		{"unicode", "🙂🙂", 2},
+		{"unicode", "🙂🙂", 4},
 	}
 
 	for _, tt := range tests {
@@ -154,3 +154,23 @@ func TestMeasureu(t *testing.T) {
 		})
 	}
 }
+
+func TestMeasureVisible(t *testing.T) {
+	tests := []struct {
+		name string
+		text string
+		want winsize.Cols
+	}{
+		{"plain", "hello", 5},
+		{"sgr", "\x1b[1mbold\x1b[22m", 4},
+		{"truecolor", "\x1b[38;2;1;2;3mab\x1b[39m", 2},
+		{"osc", "\x1b]8;;http://x\x1b\\link\x1b]8;;\x07", 4},
+		{"unterminated", "ab\x1b[3", 2},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, MeasureVisible(tt.text))
+		})
+	}
+}
@@ -0,0 +1,80 @@
+package runes
+
+import (
+	"testing"
+
+	assert "github.com/Rafael24595/go-assert/assert/test"
+
+	"github.com/Rafael24595/go-reacterm-core/engine/model/winsize"
+)
+
+func TestMeasure_DisplayWidth(t *testing.T) {
+	tests := []struct {
+		name string
+		text string
+		want winsize.Cols
+	}{
+		{"japanese", "山田太郎", 8},
+		{"halfwidth katakana", "ｶﾞ", 1},
+		{"hangul jamo", "\u1100\u1161\u11a8", 2},
+		{"combining", "e\u0301", 1},
+		{"emoji presentation", "❤️", 2},
+		{"text presentation", "❤", 1},
+		{"zwj family", "👨‍👩‍👧", 2},
+		{"skin tone", "👍🏽", 2},
+		{"flag", "🇯🇵", 2},
+		{"two flags", "🇯🇵🇪🇸", 4},
+		{"zero width joiner", "a‍b", 2},
+		{"control", "a\x07b", 2},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, Measure(tt.text))
+		})
+	}
+}
+
+func TestGraphemes(t *testing.T) {
+	assert.DeepEqual(t, []string{"e\u0301", "x"}, Graphemes("e\u0301x"))
+	assert.DeepEqual(t, []string{"🇯🇵", "🇪🇸"}, Graphemes("🇯🇵🇪🇸"))
+	assert.DeepEqual(t, []string{"👨‍👩‍👧", "!"}, Graphemes("👨‍👩‍👧!"))
+	assert.DeepEqual(t, []string{"\r\n", "a"}, Graphemes("\r\na"))
+	assert.DeepEqual(t, []string{"한", "글"}, Graphemes("한글"))
+}
+
+func TestNextPrevGrapheme(t *testing.T) {
+	buffer := []rune("ae\u0301👍🏽\nb")
+
+	assert.Equal(t, 1, NextGrapheme(buffer, 0))
+	assert.Equal(t, 3, NextGrapheme(buffer, 1))
+	assert.Equal(t, 5, NextGrapheme(buffer, 3))
+	assert.Equal(t, 7, NextGrapheme(buffer, 7))
+
+	assert.Equal(t, 3, PrevGrapheme(buffer, 5))
+	assert.Equal(t, 1, PrevGrapheme(buffer, 3))
+	assert.Equal(t, 5, PrevGrapheme(buffer, 6))
+	assert.Equal(t, 0, PrevGrapheme(buffer, 0))
+
+	assert.Equal(t, 3, SnapGrapheme(buffer, 2))
+	assert.Equal(t, 5, SnapGrapheme(buffer, 4))
+	assert.Equal(t, 3, SnapGrapheme(buffer, 3))
+}
+
+func TestCutWidth(t *testing.T) {
+	head, tail := CutWidth("山田太郎", 5)
+	assert.Equal(t, "山田", head)
+	assert.Equal(t, "太郎", tail)
+
+	head, tail = CutWidth("e\u0301abc", 2)
+	assert.Equal(t, "e\u0301a", head)
+	assert.Equal(t, "bc", tail)
+
+	head, tail = CutWidthRight("abc山田", 5)
+	assert.Equal(t, "ab", head)
+	assert.Equal(t, "c山田", tail)
+
+	head, tail = CutWidthRight("山田", 1)
+	assert.Equal(t, "山田", head)
+	assert.Equal(t, "", tail)
+}
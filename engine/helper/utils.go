@@ -5,7 +5,6 @@ import (
 	"strings"
 
 	"github.com/Rafael24595/go-reacterm-core/engine/helper/runes"
-	"github.com/Rafael24595/go-reacterm-core/engine/model/offset"
 	"github.com/Rafael24595/go-reacterm-core/engine/model/winsize"
 	"github.com/Rafael24595/go-reacterm-core/engine/render/marker"
 )
@@ -131,7 +130,7 @@ func FillLeftWithOpts(item any, width winsize.Cols, opts LogicalSizeOpts) string
 
 	fix := ""
 	if rest := width % textLen; rest != 0 {
-		fix = text[rest:]
+		_, fix = runes.CutWidthRight(text, rest)
 	}
 
 	width = width / textLen
@@ -159,7 +158,7 @@ func FillRightWithOpts(item any, width winsize.Cols, opts LogicalSizeOpts) strin
 
 	fix := ""
 	if rest := width % textLen; rest != 0 {
-		fix = text[:rest]
+		fix, _ = runes.CutWidth(text, rest)
 	}
 
 	width = width / textLen
@@ -199,17 +198,15 @@ func TrimLeft(data string, width winsize.Cols, opts TextTrimOpts) string {
 		return data
 	}
 
-	width = realSize.Sub(width)
-
-	if elipSize+width >= realSize {
-		index, _ := runes.RuneIndexToByteIndex(data, offset.Offset(width))
-		return data[index:]
+	if elipSize >= width {
+		_, tail := runes.CutWidthRight(data, width)
+		return tail
 	}
 
 	elipTotal := strings.Repeat(opts.EllipsisText, int(opts.EllipsisSize))
 
-	index, _ := runes.RuneIndexToByteIndex(data, offset.Offset(width+elipSize))
-	return elipTotal + data[index:]
+	_, tail := runes.CutWidthRight(data, width.Sub(elipSize))
+	return elipTotal + tail
 }
 
 func TrimRight(data string, width winsize.Cols, opts TextTrimOpts) string {
@@ -227,16 +224,14 @@ func TrimRight(data string, width winsize.Cols, opts TextTrimOpts) string {
 	}
 
 	if elipSize > width {
-		index, _ := runes.RuneIndexToByteIndex(data, offset.Offset(width))
-		return data[:index]
+		head, _ := runes.CutWidth(data, width)
+		return head
 	}
 
 	elipTotal := strings.Repeat(opts.EllipsisText, int(opts.EllipsisSize))
 
-	size := width.Sub(elipSize)
-	index, _ := runes.RuneIndexToByteIndex(data, offset.Offset(size))
-
-	return data[:index] + elipTotal
+	head, _ := runes.CutWidth(data, width.Sub(elipSize))
+	return head + elipTotal
 }
 
 func NumberToAlpha(n int) string {
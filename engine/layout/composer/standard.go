@@ -3,6 +3,8 @@ package composer
 import (
 	"github.com/Rafael24595/go-reacterm-core/engine/app/state"
 	"github.com/Rafael24595/go-reacterm-core/engine/app/viewmodel"
+	"github.com/Rafael24595/go-reacterm-core/engine/layout/drawable/decorator/responsive"
+	"github.com/Rafael24595/go-reacterm-core/engine/layout/drawable/spatial/zstack"
 	"github.com/Rafael24595/go-reacterm-core/engine/layout/transform/drain"
 	"github.com/Rafael24595/go-reacterm-core/engine/model/winsize"
 	"github.com/Rafael24595/go-reacterm-core/engine/render/text"
@@ -26,9 +28,11 @@ func Standard(
 	)
 
 	if staticRows > size.Rows {
-		return uiState, []text.Line{
-			*text.NewLine("Too low resolution"),
-		}
+		fallback := responsive.TooSmall(
+			winsize.New(staticRows+1, 0),
+		)
+		fallback.Drawable.Init()
+		return uiState, drain.UnitEager(size, fallback)
 	}
 
 	ctx := newRenderContext()
@@ -53,6 +57,8 @@ func Standard(
 	lines = append(lines, kernelLines...)
 	lines = append(lines, footerLines...)
 
+	lines = zstack.Compose(size, lines, vm.Overlays...)
+
 	return uiState, lines
 }
 
@@ -10,6 +10,7 @@ import (
 	"github.com/Rafael24595/go-reacterm-core/engine/app/viewmodel"
 	"github.com/Rafael24595/go-reacterm-core/engine/config/layer"
 	"github.com/Rafael24595/go-reacterm-core/engine/layout/drawable/decorator/inputline"
+	"github.com/Rafael24595/go-reacterm-core/engine/layout/drawable/decorator/responsive"
 	"github.com/Rafael24595/go-reacterm-core/engine/layout/drawable/primitive/line"
 	"github.com/Rafael24595/go-reacterm-core/engine/layout/drawable/stream/pipeline/drain"
 	"github.com/Rafael24595/go-reacterm-core/engine/model/winsize"
@@ -130,3 +131,24 @@ func TestStandard_InitializeLayers(t *testing.T) {
 	assert.False(t, vm.Kernel.HasNext())
 	assert.False(t, vm.Footer.HasNext())
 }
+
+func TestStandard_TooSmall(t *testing.T) {
+	size := winsize.Winsize{Rows: 3, Cols: 30}
+
+	vm := viewmodel.New()
+
+	vm.Header.Push(
+		drain.UnitFromLines(
+			*text.NewLine("ONE"),
+			*text.NewLine("TWO"),
+			*text.NewLine("THREE"),
+			*text.NewLine("FOUR"),
+		),
+	)
+
+	_, lines := Standard(state.NewUIState(), *vm, size)
+
+	assert.Len(t, 2, lines)
+	assert.Equal(t, responsive.TooSmallText, text.LineToString(&lines[0]))
+	assert.Equal(t, "30x3, needs 30x5", text.LineToString(&lines[1]))
+}
@@ -2,6 +2,7 @@ package drawable
 
 import (
 	"github.com/Rafael24595/go-reacterm-core/engine/commons/structure/set"
+	"github.com/Rafael24595/go-reacterm-core/engine/layout/sheet"
 )
 
 const (
@@ -12,20 +13,22 @@ const (
 )
 
 type Builder struct {
-	name string
-	tags set.Set[string]
-	init InitFunc
-	wipe WipeFunc
-	draw DrawFunc
+	name    string
+	tags    set.Set[string]
+	init    InitFunc
+	wipe    WipeFunc
+	draw    DrawFunc
+	measure MeasureFunc
 }
 
 func NewBuilder() *Builder {
 	return &Builder{
-		name: "",
-		tags: set.NewSet[string](),
-		init: nil,
-		wipe: nil,
-		draw: nil,
+		name:    "",
+		tags:    set.NewSet[string](),
+		init:    nil,
+		wipe:    nil,
+		draw:    nil,
+		measure: nil,
 	}
 }
 
@@ -59,6 +62,11 @@ func (b *Builder) Draw(draw DrawFunc) *Builder {
 	return b
 }
 
+func (b *Builder) Measure(measure MeasureFunc) *Builder {
+	b.measure = measure
+	return b
+}
+
 func (b *Builder) makeTags() set.Set[string] {
 	tags := set.NewSet[string]()
 
@@ -83,18 +91,31 @@ func (b *Builder) makeTags() set.Set[string] {
 	return tags
 }
 
-func (b *Builder) toDrawable() Drawable {
+// toDrawable binds the unit to the style sheet, which needs its name and
+// tags to find the rules that apply while it is drawn.
+func (b *Builder) toDrawable(tags set.Set[string]) Drawable {
+	target := sheet.Target{
+		Name: b.name,
+		Tags: tags,
+	}
+
+	init, draw := sheet.Bind(target, sheet.InitFunc(b.init), sheet.DrawFunc(b.draw))
+	measure := sheet.BindMeasure(target, sheet.MeasureFunc(b.measure))
+
 	return Drawable{
-		Init: b.init,
-		Wipe: b.wipe,
-		Draw: b.draw,
+		Init:    InitFunc(init),
+		Wipe:    b.wipe,
+		Draw:    DrawFunc(draw),
+		Measure: MeasureFunc(measure),
 	}
 }
 
 func (b *Builder) ToUnit() Unit {
+	tags := b.makeTags()
+
 	return Unit{
 		Name:     b.name,
-		Tags:     b.makeTags(),
-		Drawable: b.toDrawable(),
+		Tags:     tags,
+		Drawable: b.toDrawable(tags),
 	}
 }
@@ -1,6 +1,8 @@
 package box
 
 import (
+	"strings"
+
 	assert "github.com/Rafael24595/go-assert/assert/runtime"
 
 	"github.com/Rafael24595/go-reacterm-core/engine/config/padding/cols"
@@ -24,11 +26,21 @@ const (
 	default_padding = winsize.Cols(0)
 )
 
+type label struct {
+	text  string
+	align style.HorizontalPosition
+}
+
 type BoxUnit struct {
 	loaded    bool
 	paddingY  winsize.Rows
 	paddingX  winsize.Cols
 	separator marker.BoxSeparatorMeta
+	sides     Side
+	title     label
+	footer    label
+	atom      style.Atom
+	paint     style.Paint
 	unit      drawable.Unit
 }
 
@@ -38,6 +50,11 @@ func New(unit drawable.Unit) *BoxUnit {
 		paddingY:  winsize.Rows(default_padding),
 		paddingX:  default_padding,
 		separator: marker.DefaultBoxSeparator,
+		sides:     SideAll,
+		title:     label{},
+		footer:    label{},
+		atom:      style.AtmNone,
+		paint:     style.PaintNone(),
 		unit:      unit,
 	}
 }
@@ -51,6 +68,42 @@ func (u *BoxUnit) Separator(separator marker.BoxSeparatorMeta) *BoxUnit {
 	return u
 }
 
+// Style draws the border with one of the marker presets.
+func (u *BoxUnit) Style(preset marker.BoxStyle) *BoxUnit {
+	u.separator = marker.BoxSeparator(preset)
+	return u
+}
+
+// Title embeds a label in the top border, on the left unless an
+// alignment is given.
+func (u *BoxUnit) Title(title string, align ...style.HorizontalPosition) *BoxUnit {
+	u.title = makeLabel(title, align...)
+	return u
+}
+
+// Footer embeds a label in the bottom border, on the left unless an
+// alignment is given.
+func (u *BoxUnit) Footer(footer string, align ...style.HorizontalPosition) *BoxUnit {
+	u.footer = makeLabel(footer, align...)
+	return u
+}
+
+// Sides sets which borders are drawn, all of them by default.
+func (u *BoxUnit) Sides(sides Side) *BoxUnit {
+	u.sides = sides
+	return u
+}
+
+func (u *BoxUnit) BorderAtom(atom style.Atom) *BoxUnit {
+	u.atom = atom
+	return u
+}
+
+func (u *BoxUnit) BorderPaint(paint style.Paint) *BoxUnit {
+	u.paint = paint
+	return u
+}
+
 func (u *BoxUnit) PaddingY(padding winsize.Rows) *BoxUnit {
 	u.paddingY = padding
 	return u
@@ -103,21 +156,21 @@ func (u *BoxUnit) draw(size winsize.Winsize) ([]text.Line, bool) {
 
 // TODO: investigate spec overflow.
 func (u *BoxUnit) styleLines(size winsize.Winsize, lines ...text.Line) []text.Line {
-	vertical := horizontalStaticSize(u.separator)
+	vertical := u.sideCols()
+	available := size.Cols.Sub(vertical)
 
 	maxLine := text.MaxLineMeasure(size.Cols, lines...)
-	measure := min(maxLine+vertical, size.Cols)
+	maxLine = min(max(maxLine, u.labelCols()), available)
 
-	specCover := style.SpecRepeatLeft(measure)
-	cover := text.LineFromFragments(
-		*text.NewFragment(u.separator.Top).AddSpec(specCover),
-	)
+	measure := min(maxLine+vertical, size.Cols)
 
 	result := make([]text.Line, 0)
 
-	result = append(result, *cover)
-
-	available := size.Cols.Sub(vertical)
+	if u.sides.HasAny(SideTop) {
+		cover := u.makeEdge(measure, u.separator.Top,
+			u.separator.TopLeft, u.separator.TopRight, u.title)
+		result = append(result, cover)
+	}
 
 	transformer := padding.Cols(
 		hint.Fixed(maxLine),
@@ -132,38 +185,157 @@ func (u *BoxUnit) styleLines(size winsize.Winsize, lines ...text.Line) []text.Li
 		}
 	}
 
-	result = append(result, *cover)
+	if u.sides.HasAny(SideBottom) {
+		cover := u.makeEdge(measure, u.separator.Bottom,
+			u.separator.BottomLeft, u.separator.BottomRight, u.footer)
+		result = append(result, cover)
+	}
 
 	return result
 }
 
+// makeEdge fills a horizontal border between its corners, with the
+// label cut to fit and placed by its alignment.
+func (u *BoxUnit) makeEdge(width winsize.Cols, pattern, left, right string, lbl label) text.Line {
+	if !u.sides.HasAny(SideLeft) {
+		left = ""
+	} else if left == "" {
+		left = pattern
+	}
+
+	if !u.sides.HasAny(SideRight) {
+		right = ""
+	} else if right == "" {
+		right = pattern
+	}
+
+	corners := runes.Measure(left) + runes.Measure(right)
+	if corners > width {
+		return *text.LineFromFragments(
+			u.borderFragment(repeatCols(pattern, width)),
+		)
+	}
+
+	inner := width - corners
+
+	decorated := ""
+	if lbl.text != "" {
+		space := marker.DefaultPaddingText
+		decorated, _ = runes.CutWidth(space+lbl.text+space, inner)
+	}
+
+	free := inner.Sub(runes.Measure(decorated))
+
+	lead := min(1, free)
+	if decorated == "" {
+		lead = 0
+	}
+
+	switch lbl.align {
+	case style.Center:
+		lead = free / 2
+	case style.Right:
+		lead = free.Sub(lead)
+	}
+
+	frags := make([]text.Fragment, 0, 5)
+	frags = append(frags,
+		u.borderFragment(left),
+		u.borderFragment(repeatCols(pattern, lead)),
+	)
+
+	if decorated != "" {
+		frags = append(frags, u.borderFragment(decorated))
+	}
+
+	frags = append(frags,
+		u.borderFragment(repeatCols(pattern, free-lead)),
+		u.borderFragment(right),
+	)
+
+	return *text.LineFromFragments(frags...)
+}
+
 func (u *BoxUnit) wrapLine(line text.Line) text.Line {
 	frags := make([]text.Fragment, 0)
-
-	frags = append(frags, *text.NewFragment(u.separator.Left))
+	if u.sides.HasAny(SideLeft) {
+		frags = append(frags, u.borderFragment(u.separator.Left))
+	}
 	frags = append(frags, line.Text...)
-	frags = append(frags, *text.NewFragment(u.separator.Right))
+	if u.sides.HasAny(SideRight) {
+		frags = append(frags, u.borderFragment(u.separator.Right))
+	}
 
 	line.Text = frags
-
 	return line
 }
 
+func (u *BoxUnit) borderFragment(border string) text.Fragment {
+	return *text.NewFragment(border).
+		SetRole(style.RoleBorder).
+		AddAtom(u.atom).
+		SetPaint(u.paint)
+}
+
 func (u *BoxUnit) computeInnerSize(size winsize.Winsize) winsize.Winsize {
-	vertical := winsize.Rows(2)
-	rows := size.Rows.Sub(vertical)
+	vertical := winsize.Rows(0)
+	if u.sides.HasAny(SideTop) {
+		vertical += 1
+	}
+	if u.sides.HasAny(SideBottom) {
+		vertical += 1
+	}
 
-	horizontal := horizontalStaticSize(u.separator)
-	cols := size.Cols.Sub(horizontal)
+	rows := size.Rows.Sub(vertical)
+	cols := size.Cols.Sub(u.sideCols())
 
 	return winsize.New(rows, cols)
 }
 
-func horizontalSeparatorSize(separator marker.BoxSeparatorMeta) (winsize.Cols, winsize.Cols) {
-	return runes.Measure(separator.Left), runes.Measure(separator.Right)
+func (u *BoxUnit) sideCols() winsize.Cols {
+	cols := winsize.Cols(0)
+	if u.sides.HasAny(SideLeft) {
+		cols += runes.Measure(u.separator.Left)
+	}
+	if u.sides.HasAny(SideRight) {
+		cols += runes.Measure(u.separator.Right)
+	}
+	return cols
+}
+
+// labelCols is the room the labels need: their text, a space on each
+// side and a border cell on each side of those.
+func (u *BoxUnit) labelCols() winsize.Cols {
+	cols := winsize.Cols(0)
+	for _, lbl := range []label{u.title, u.footer} {
+		if lbl.text != "" {
+			cols = max(cols, runes.Measure(lbl.text)+4)
+		}
+	}
+	return cols
+}
+
+func makeLabel(text string, align ...style.HorizontalPosition) label {
+	position := style.Left
+	if len(align) > 0 {
+		position = align[0]
+	}
+
+	return label{
+		text:  text,
+		align: position,
+	}
 }
 
-func horizontalStaticSize(separator marker.BoxSeparatorMeta) winsize.Cols {
-	left, right := horizontalSeparatorSize(separator)
-	return left + right
+func repeatCols(pattern string, cols winsize.Cols) string {
+	size := runes.Measure(pattern)
+	if size == 0 || cols == 0 {
+		return ""
+	}
+
+	count := int(cols / size)
+	rest := int(cols % size)
+
+	return strings.Repeat(pattern, count) +
+		strings.Repeat(marker.DefaultPaddingText, rest)
 }
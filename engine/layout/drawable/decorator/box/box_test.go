@@ -1,10 +1,19 @@
 package box
 
 import (
+	"strings"
 	"testing"
 
 	assert "github.com/Rafael24595/go-assert/assert/test"
 
+	"github.com/Rafael24595/go-reacterm-core/engine/layout/drawable/primitive/line"
+	"github.com/Rafael24595/go-reacterm-core/engine/model/winsize"
+	"github.com/Rafael24595/go-reacterm-core/engine/render/marker"
+	"github.com/Rafael24595/go-reacterm-core/engine/render/style"
+	"github.com/Rafael24595/go-reacterm-core/engine/render/styler"
+	"github.com/Rafael24595/go-reacterm-core/engine/render/text"
+	"github.com/Rafael24595/go-reacterm-core/engine/terminal/capability"
+
 	drawable_test "github.com/Rafael24595/go-reacterm-core/test/engine/layout/drawable"
 )
 
@@ -17,9 +26,144 @@ func TestBox_UnitBasicSuite(t *testing.T) {
 func TestBox_Init_ShouldPropagateToChild(t *testing.T) {
 	mock := &drawable_test.MockUnit{}
 	unit := New(mock.ToUnit())
-
 	unit.init()
-
 	assert.True(t, unit.loaded)
 	assert.Greater(t, 0, mock.InitCalled)
 }
+
+func render(lines []text.Line) string {
+	spec := styler.NewDefaultSpec()
+
+	result := make([]string, len(lines))
+	for i := range lines {
+		line := spec.Materialize(lines[i], winsize.New(1, 100))
+		result[i] = text.LineToString(&line)
+	}
+	return strings.Join(result, "\n")
+}
+
+func draw(box *BoxUnit, size winsize.Winsize) string {
+	unit := box.ToUnit()
+	unit.Drawable.Init()
+
+	lines, _ := unit.Drawable.Draw(size)
+	return render(lines)
+}
+
+func TestBox_DefaultSeparator(t *testing.T) {
+	box := New(line.UnitFromLines(*text.NewLine("ab")))
+
+	assert.Equal(t, "----\n|ab|\n----", draw(box, winsize.New(5, 10)))
+}
+
+func TestBox_StyleCorners(t *testing.T) {
+	box := New(line.UnitFromLines(*text.NewLine("ab"))).
+		Style(marker.BoxRounded)
+
+	assert.Equal(t, "╭──╮\n│ab│\n╰──╯", draw(box, winsize.New(5, 10)))
+}
+
+func TestBox_StyleFallsBackToAscii(t *testing.T) {
+	previous := capability.Current()
+	defer capability.Set(previous)
+
+	capability.Set(capability.Basic())
+
+	box := New(line.UnitFromLines(*text.NewLine("ab"))).
+		Style(marker.BoxDouble)
+
+	assert.Equal(t, "+--+\n|ab|\n+--+", draw(box, winsize.New(5, 10)))
+}
+
+func TestBox_TitleAndFooter(t *testing.T) {
+	content := line.UnitFromLines(*text.NewLine("abcdefghij"))
+
+	box := New(content).
+		Style(marker.BoxSingle).
+		Title("Logs").
+		Footer("3/9", style.Right)
+
+	expected := strings.Join([]string{
+		"┌─ Logs ───┐",
+		"│abcdefghij│",
+		"└──── 3/9 ─┘",
+	}, "\n")
+
+	assert.Equal(t, expected, draw(box, winsize.New(5, 20)))
+}
+
+func TestBox_TitleCentered(t *testing.T) {
+	box := New(line.UnitFromLines(*text.NewLine("abcdefghij"))).
+		Style(marker.BoxSingle).
+		Title("ab", style.Center)
+
+	lines := strings.Split(draw(box, winsize.New(5, 20)), "\n")
+	assert.Equal(t, "┌─── ab ───┐", lines[0])
+}
+
+func TestBox_TitleWidensBox(t *testing.T) {
+	box := New(line.UnitFromLines(*text.NewLine("a"))).
+		Style(marker.BoxSingle).
+		Title("Panel")
+
+	expected := strings.Join([]string{
+		"┌─ Panel ─┐",
+		"│    a    │",
+		"└─────────┘",
+	}, "\n")
+
+	assert.Equal(t, expected, draw(box, winsize.New(5, 20)))
+}
+
+func TestBox_TitleIsCut(t *testing.T) {
+	box := New(line.UnitFromLines(*text.NewLine("abc"))).
+		Style(marker.BoxSingle).
+		Title("Long title")
+
+	lines := strings.Split(draw(box, winsize.New(5, 7)), "\n")
+	assert.Equal(t, "┌ Long┐", lines[0])
+}
+
+func TestBox_TitleFitsNarrowWidth(t *testing.T) {
+	box := New(line.UnitFromLines(*text.NewLine("ab"))).
+		Style(marker.BoxSingle).
+		Title("A title longer than the box")
+
+	expected := strings.Join([]string{
+		"┌ A title longe┐",
+		"│      ab      │",
+		"└──────────────┘",
+	}, "\n")
+
+	assert.Equal(t, expected, draw(box, winsize.New(5, 16)))
+}
+
+func TestBox_Sides(t *testing.T) {
+	box := New(line.UnitFromLines(*text.NewLine("ab"))).
+		Style(marker.BoxSingle).
+		Sides(SideTop | SideLeft)
+
+	assert.Equal(t, "┌──\n│ab", draw(box, winsize.New(5, 10)))
+
+	box = New(line.UnitFromLines(*text.NewLine("ab"))).
+		Style(marker.BoxSingle).
+		Sides(SideLeft | SideRight)
+
+	assert.Equal(t, "│ab│", draw(box, winsize.New(5, 10)))
+}
+
+func TestBox_BorderStyle(t *testing.T) {
+	box := New(line.UnitFromLines(*text.NewLine("ab"))).
+		BorderAtom(style.AtmBold).
+		BorderPaint(style.Paint{Fg: style.Ansi(style.Red)})
+
+	unit := box.ToUnit()
+	unit.Drawable.Init()
+
+	lines, _ := unit.Drawable.Draw(winsize.New(5, 10))
+
+	left := lines[1].Text[0]
+	assert.Equal(t, style.RoleBorder, left.Role)
+	assert.True(t, left.Atom.HasAny(style.AtmBold))
+	assert.Equal(t, style.Ansi(style.Red), left.Paint.Fg)
+}
@@ -0,0 +1,25 @@
+package box
+
+type Side uint8
+
+const (
+	SideTop Side = 1 << iota
+	SideBottom
+	SideLeft
+	SideRight
+)
+
+const SideAll = SideTop | SideBottom | SideLeft | SideRight
+
+func (s Side) HasAny(sides ...Side) bool {
+	for _, side := range sides {
+		if s&side != 0 {
+			return true
+		}
+	}
+	return false
+}
+
+func (s Side) HasNone(sides ...Side) bool {
+	return !s.HasAny(sides...)
+}
@@ -8,6 +8,7 @@ import (
 	"github.com/Rafael24595/go-reacterm-core/engine/model/winsize"
 	"github.com/Rafael24595/go-reacterm-core/engine/render/marker"
 	"github.com/Rafael24595/go-reacterm-core/engine/render/text"
+	"github.com/Rafael24595/go-reacterm-core/engine/render/theme"
 
 	drawable_drain "github.com/Rafael24595/go-reacterm-core/engine/layout/drawable/stream/pipeline/drain"
 )
@@ -25,7 +26,7 @@ func New(unit drawable.Unit) *InputLineUnit {
 	return &InputLineUnit{
 		loaded: false,
 		status: true,
-		prompt: marker.DefaultPromptText,
+		prompt: theme.Current().Glyph(theme.GlyphPrompt),
 		unit:   unit,
 	}
 }
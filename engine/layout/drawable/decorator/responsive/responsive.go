@@ -0,0 +1,115 @@
+package responsive
+
+import (
+	assert "github.com/Rafael24595/go-assert/assert/runtime"
+
+	"github.com/Rafael24595/go-reacterm-core/engine/commons/structure/set"
+	"github.com/Rafael24595/go-reacterm-core/engine/config/breakpoint"
+	"github.com/Rafael24595/go-reacterm-core/engine/layout/drawable"
+	"github.com/Rafael24595/go-reacterm-core/engine/model/winsize"
+	"github.com/Rafael24595/go-reacterm-core/engine/render/text"
+)
+
+const Name = "responsive_unit"
+
+const unselected = -2
+
+// ResponsiveUnit draws the first alternative whose breakpoint matches
+// the size it is given, or the fallback when none does. The choice is
+// kept across the chunks of a draw and made again after a wipe.
+type ResponsiveUnit struct {
+	loaded   bool
+	fallback drawable.Unit
+	cases    []breakpoint.Case[drawable.Unit]
+	units    []drawable.Unit
+	selected int
+	started  set.Set[int]
+}
+
+func New(fallback drawable.Unit) *ResponsiveUnit {
+	return &ResponsiveUnit{
+		loaded:   false,
+		fallback: fallback,
+		cases:    make([]breakpoint.Case[drawable.Unit], 0),
+		units:    []drawable.Unit{fallback},
+		selected: unselected,
+		started:  set.NewSet[int](),
+	}
+}
+
+func (u *ResponsiveUnit) When(when breakpoint.Breakpoint, unit drawable.Unit) *ResponsiveUnit {
+	assert.False(u.loaded, drawable.MessageNewElement)
+
+	u.cases = append(u.cases, breakpoint.When(when, unit))
+	u.units = append(u.units, unit)
+	return u
+}
+
+func (u *ResponsiveUnit) ToUnit() drawable.Unit {
+	return drawable.NewBuilder().
+		Name(Name).
+		MergeTags(u.tags()).
+		Init(u.init).
+		Wipe(u.wipe).
+		Draw(u.draw).
+		Measure(u.measure).
+		ToUnit()
+}
+
+func (u *ResponsiveUnit) tags() set.Set[string] {
+	tags := set.NewSet[string]()
+	for _, unit := range u.units {
+		tags.Merge(unit.Tags)
+	}
+	return tags
+}
+
+func (u *ResponsiveUnit) init() {
+	u.loaded = true
+	u.selected = unselected
+	u.started = set.NewSet[int]()
+}
+
+func (u *ResponsiveUnit) wipe() {
+	if u.selected != unselected {
+		u.units[u.selected].Drawable.Wipe()
+	}
+	u.selected = unselected
+}
+
+func (u *ResponsiveUnit) draw(size winsize.Winsize) ([]text.Line, bool) {
+	assert.True(u.loaded, drawable.MessageInitialized)
+
+	if u.selected == unselected {
+		u.selected = u.pick(size)
+		u.start(u.selected)
+	}
+
+	return u.units[u.selected].Drawable.Draw(size)
+}
+
+// start initializes an alternative the first time it is chosen and
+// rewinds it when it comes back after another one was drawn.
+func (u *ResponsiveUnit) start(index int) {
+	unit := u.units[index]
+	if u.started.Has(index) {
+		unit.Drawable.Wipe()
+		return
+	}
+
+	u.started.Add(index)
+	unit.Drawable.Init()
+}
+
+func (u *ResponsiveUnit) measure(size winsize.Winsize) winsize.Winsize {
+	unit := u.units[u.pick(size)]
+	if unit.Drawable.Measure == nil {
+		return winsize.Winsize{}
+	}
+	return unit.Drawable.Measure(size)
+}
+
+func (u *ResponsiveUnit) pick(size winsize.Winsize) int {
+	_, index := breakpoint.Pick(size, u.fallback, u.cases...)
+	return index + 1
+}
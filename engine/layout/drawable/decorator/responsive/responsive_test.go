@@ -0,0 +1,127 @@
+package responsive
+
+import (
+	"strings"
+	"testing"
+
+	assert "github.com/Rafael24595/go-assert/assert/test"
+
+	"github.com/Rafael24595/go-reacterm-core/engine/config/breakpoint"
+	"github.com/Rafael24595/go-reacterm-core/engine/layout/drawable"
+	"github.com/Rafael24595/go-reacterm-core/engine/layout/drawable/primitive/line"
+	"github.com/Rafael24595/go-reacterm-core/engine/model/winsize"
+	"github.com/Rafael24595/go-reacterm-core/engine/render/text"
+
+	drawable_test "github.com/Rafael24595/go-reacterm-core/test/engine/layout/drawable"
+)
+
+func render(lines []text.Line) string {
+	result := make([]string, len(lines))
+	for i := range lines {
+		result[i] = text.LineToString(&lines[i])
+	}
+	return strings.Join(result, "\n")
+}
+
+func labelled(label string) drawable.Unit {
+	return line.UnitFromLines(*text.NewLine(label))
+}
+
+func TestResponsive_UnitBasicSuite(t *testing.T) {
+	mock := &drawable_test.MockUnit{}
+	unit := New(mock.ToUnit()).ToUnit()
+	drawable_test.Test_UnitBasicSuite(t, unit)
+}
+
+func TestTooSmall_UnitBasicSuite(t *testing.T) {
+	unit := NewTooSmall(winsize.New(10, 40)).ToUnit()
+	drawable_test.Test_UnitBasicSuite(t, unit)
+}
+
+func TestResponsive_PicksBySize(t *testing.T) {
+	unit := New(labelled("narrow")).
+		When(breakpoint.MinCols(80), labelled("wide")).
+		When(breakpoint.MinCols(40), labelled("medium")).
+		ToUnit()
+
+	cases := []struct {
+		cols     winsize.Cols
+		expected string
+	}{
+		{cols: 20, expected: "narrow"},
+		{cols: 40, expected: "medium"},
+		{cols: 120, expected: "wide"},
+		{cols: 20, expected: "narrow"},
+	}
+
+	unit.Drawable.Init()
+	for _, c := range cases {
+		unit.Drawable.Wipe()
+
+		lines, hasNext := unit.Drawable.Draw(winsize.New(5, c.cols))
+
+		assert.False(t, hasNext)
+		assert.Equal(t, c.expected, render(lines))
+	}
+}
+
+func TestResponsive_KeepsChoiceUntilWipe(t *testing.T) {
+	narrow := &drawable_test.MockUnit{
+		Batch: 1,
+		Lines: []text.Line{*text.NewLine("n0"), *text.NewLine("n1")},
+	}
+
+	unit := New(narrow.ToUnit()).
+		When(breakpoint.MinCols(80), labelled("wide")).
+		ToUnit()
+
+	unit.Drawable.Init()
+
+	lines, _ := unit.Drawable.Draw(winsize.New(1, 20))
+	assert.Equal(t, "n0", render(lines))
+
+	lines, _ = unit.Drawable.Draw(winsize.New(1, 100))
+	assert.Equal(t, "n1", render(lines))
+
+	unit.Drawable.Wipe()
+
+	lines, _ = unit.Drawable.Draw(winsize.New(1, 100))
+	assert.Equal(t, "wide", render(lines))
+}
+
+func TestResponsive_MeasuresPicked(t *testing.T) {
+	unit := New(line.UnitFromLines()).
+		When(breakpoint.MinCols(80), labelled("sidebar")).
+		ToUnit()
+
+	unit.Drawable.Init()
+
+	narrow := unit.Drawable.Measure(winsize.New(5, 40))
+	assert.Equal(t, 0, narrow.Cols)
+
+	wide := unit.Drawable.Measure(winsize.New(5, 100))
+	assert.Equal(t, 7, wide.Cols)
+}
+
+func TestTooSmall_CentersMessage(t *testing.T) {
+	unit := TooSmall(winsize.New(10, 40))
+	unit.Drawable.Init()
+
+	lines, hasNext := unit.Drawable.Draw(winsize.New(5, 30))
+
+	assert.False(t, hasNext)
+	assert.Len(t, 3, lines)
+	assert.Equal(t, "", text.LineToString(&lines[0]))
+	assert.Equal(t, TooSmallText, text.LineToString(&lines[1]))
+	assert.Equal(t, "30x5, needs 40x10", text.LineToString(&lines[2]))
+}
+
+func TestTooSmall_CutsToRows(t *testing.T) {
+	unit := TooSmall(winsize.New(10, 40))
+	unit.Drawable.Init()
+
+	lines, _ := unit.Drawable.Draw(winsize.New(1, 30))
+
+	assert.Len(t, 1, lines)
+	assert.Equal(t, TooSmallText, text.LineToString(&lines[0]))
+}
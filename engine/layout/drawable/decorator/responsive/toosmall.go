@@ -0,0 +1,86 @@
+package responsive
+
+import (
+	"fmt"
+
+	assert "github.com/Rafael24595/go-assert/assert/runtime"
+
+	"github.com/Rafael24595/go-reacterm-core/engine/layout/drawable"
+	"github.com/Rafael24595/go-reacterm-core/engine/model/winsize"
+	"github.com/Rafael24595/go-reacterm-core/engine/render/style"
+	"github.com/Rafael24595/go-reacterm-core/engine/render/text"
+)
+
+const NameTooSmall = "too_small_unit"
+
+const TooSmallText = "Terminal too small"
+
+// TooSmallUnit tells the user the terminal is under the size a screen
+// needs, centered in whatever room is left.
+type TooSmallUnit struct {
+	loaded bool
+	min    winsize.Winsize
+}
+
+func NewTooSmall(min winsize.Winsize) *TooSmallUnit {
+	return &TooSmallUnit{
+		loaded: false,
+		min:    min,
+	}
+}
+
+func TooSmall(min winsize.Winsize) drawable.Unit {
+	return NewTooSmall(min).ToUnit()
+}
+
+func (u *TooSmallUnit) ToUnit() drawable.Unit {
+	return drawable.NewBuilder().
+		Name(NameTooSmall).
+		Init(u.init).
+		Wipe(u.wipe).
+		Draw(u.draw).
+		ToUnit()
+}
+
+func (u *TooSmallUnit) init() {
+	u.loaded = true
+}
+
+func (u *TooSmallUnit) wipe() {}
+
+func (u *TooSmallUnit) draw(size winsize.Winsize) ([]text.Line, bool) {
+	assert.True(u.loaded, drawable.MessageInitialized)
+
+	center := style.SpecFromKind(style.SpcKindPaddingCenter)
+
+	message := []text.Line{
+		*text.NewLine(TooSmallText, center).
+			SetRole(style.RoleError),
+		*text.NewLine(u.detail(size), center).
+			SetRole(style.RoleMuted),
+	}
+
+	if size.Rows < winsize.Rows(len(message)) {
+		return message[:size.Rows], false
+	}
+
+	top := (size.Rows - winsize.Rows(len(message))) / 2
+
+	lines := make([]text.Line, top, size.Rows)
+	for i := range lines {
+		lines[i] = *text.EmptyLine()
+	}
+
+	return append(lines, message...), false
+}
+
+func (u *TooSmallUnit) detail(size winsize.Winsize) string {
+	need := winsize.New(
+		max(u.min.Rows, size.Rows),
+		max(u.min.Cols, size.Cols),
+	)
+
+	return fmt.Sprintf("%dx%d, needs %dx%d",
+		size.Cols, size.Rows, need.Cols, need.Rows,
+	)
+}
@@ -0,0 +1,126 @@
+package viewport
+
+import (
+	"github.com/Rafael24595/go-reacterm-core/engine/helper/math"
+	"github.com/Rafael24595/go-reacterm-core/engine/model/key"
+	"github.com/Rafael24595/go-reacterm-core/engine/model/winsize"
+)
+
+// State keeps the offsets of a viewport between frames. Units are built
+// again on every view, so the screen that owns the viewport owns its
+// state too. The sizes seen on the last draw bound the offsets.
+type State struct {
+	row         uint
+	col         winsize.Cols
+	view        winsize.Winsize
+	contentRows uint
+	contentCols winsize.Cols
+}
+
+func NewState() *State {
+	return &State{
+		row:         0,
+		col:         0,
+		view:        winsize.Winsize{},
+		contentRows: 0,
+		contentCols: 0,
+	}
+}
+
+func (s *State) Row() uint {
+	return s.row
+}
+
+func (s *State) Col() winsize.Cols {
+	return s.col
+}
+
+func (s *State) ScrollUp(rows uint) *State {
+	s.row = math.SubClampZero(s.row, rows)
+	return s
+}
+
+func (s *State) ScrollDown(rows uint) *State {
+	s.row = min(s.row+rows, s.lastRow())
+	return s
+}
+
+func (s *State) ScrollLeft(cols winsize.Cols) *State {
+	s.col = s.col.Sub(cols)
+	return s
+}
+
+func (s *State) ScrollRight(cols winsize.Cols) *State {
+	s.col = min(s.col+cols, s.lastCol())
+	return s
+}
+
+func (s *State) PageUp() *State {
+	return s.ScrollUp(s.page())
+}
+
+func (s *State) PageDown() *State {
+	return s.ScrollDown(s.page())
+}
+
+func (s *State) Top() *State {
+	s.row = 0
+	return s
+}
+
+func (s *State) Bottom() *State {
+	s.row = s.lastRow()
+	return s
+}
+
+// Scroll moves the viewport for the navigation keys and reports whether
+// the key was one of them, so screens can forward their unhandled keys.
+func (s *State) Scroll(action key.Action) bool {
+	switch action {
+	case key.ActionArrowUp, key.ActionWheelUp:
+		s.ScrollUp(1)
+	case key.ActionArrowDown, key.ActionWheelDown:
+		s.ScrollDown(1)
+	case key.ActionArrowLeft:
+		s.ScrollLeft(1)
+	case key.ActionArrowRight:
+		s.ScrollRight(1)
+	case key.ActionPageUp:
+		s.PageUp()
+	case key.ActionPageDown:
+		s.PageDown()
+	case key.ActionHome:
+		s.Top()
+	case key.ActionEnd:
+		s.Bottom()
+	default:
+		return false
+	}
+	return true
+}
+
+// HasMore reports whether there is content below the viewport.
+func (s *State) HasMore() bool {
+	return s.row < s.lastRow()
+}
+
+func (s *State) update(view winsize.Winsize, rows uint, cols winsize.Cols) {
+	s.view = view
+	s.contentRows = rows
+	s.contentCols = cols
+
+	s.row = min(s.row, s.lastRow())
+	s.col = min(s.col, s.lastCol())
+}
+
+func (s *State) page() uint {
+	return max(1, uint(s.view.Rows))
+}
+
+func (s *State) lastRow() uint {
+	return math.SubClampZero(s.contentRows, uint(s.view.Rows))
+}
+
+func (s *State) lastCol() winsize.Cols {
+	return s.contentCols.Sub(s.view.Cols)
+}
@@ -0,0 +1,70 @@
+package viewport
+
+import (
+	"testing"
+
+	assert "github.com/Rafael24595/go-assert/assert/test"
+
+	"github.com/Rafael24595/go-reacterm-core/engine/model/key"
+	"github.com/Rafael24595/go-reacterm-core/engine/model/winsize"
+)
+
+func TestState_ClampsToContent(t *testing.T) {
+	state := NewState()
+	state.update(winsize.New(4, 10), 10, 30)
+
+	state.ScrollDown(100)
+	assert.Equal(t, 6, state.Row())
+	assert.False(t, state.HasMore())
+
+	state.ScrollUp(100)
+	assert.Equal(t, 0, state.Row())
+	assert.True(t, state.HasMore())
+
+	state.ScrollRight(100)
+	assert.Equal(t, 20, state.Col())
+
+	state.ScrollLeft(5)
+	assert.Equal(t, 15, state.Col())
+}
+
+func TestState_Pages(t *testing.T) {
+	state := NewState()
+	state.update(winsize.New(4, 10), 10, 10)
+
+	state.PageDown()
+	assert.Equal(t, 4, state.Row())
+
+	state.Bottom()
+	assert.Equal(t, 6, state.Row())
+
+	state.PageUp()
+	assert.Equal(t, 2, state.Row())
+
+	state.Top()
+	assert.Equal(t, 0, state.Row())
+}
+
+func TestState_UpdateKeepsOffsetInRange(t *testing.T) {
+	state := NewState()
+	state.update(winsize.New(4, 10), 10, 10)
+	state.Bottom()
+
+	state.update(winsize.New(8, 10), 10, 10)
+
+	assert.Equal(t, 2, state.Row())
+}
+
+func TestState_Scroll(t *testing.T) {
+	state := NewState()
+	state.update(winsize.New(4, 10), 10, 10)
+
+	assert.True(t, state.Scroll(key.ActionArrowDown))
+	assert.True(t, state.Scroll(key.ActionWheelDown))
+	assert.Equal(t, 2, state.Row())
+
+	assert.True(t, state.Scroll(key.ActionEnd))
+	assert.Equal(t, 6, state.Row())
+
+	assert.False(t, state.Scroll(key.ActionEnter))
+}
@@ -0,0 +1,155 @@
+package viewport
+
+import (
+	"strings"
+
+	assert "github.com/Rafael24595/go-assert/assert/runtime"
+
+	"github.com/Rafael24595/go-reacterm-core/engine/layout/drawable"
+	"github.com/Rafael24595/go-reacterm-core/engine/layout/transform/drain"
+	"github.com/Rafael24595/go-reacterm-core/engine/model/winsize"
+	"github.com/Rafael24595/go-reacterm-core/engine/render/marker"
+	"github.com/Rafael24595/go-reacterm-core/engine/render/style"
+	"github.com/Rafael24595/go-reacterm-core/engine/render/styler"
+	"github.com/Rafael24595/go-reacterm-core/engine/render/text"
+	"github.com/Rafael24595/go-reacterm-core/engine/render/theme"
+)
+
+const Name = "viewport_unit"
+
+const gutterCols = winsize.Cols(1)
+
+// ViewportUnit shows a window of the whole content of a unit, moved by
+// its own state instead of the pager, so several viewports on the same
+// screen scroll independently. It always fits in a single draw.
+type ViewportUnit struct {
+	loaded      bool
+	unit        drawable.Unit
+	state       *State
+	scrollbar   bool
+	contentCols winsize.Cols
+}
+
+func New(unit drawable.Unit, state *State) *ViewportUnit {
+	return &ViewportUnit{
+		loaded:      false,
+		unit:        unit,
+		state:       state,
+		scrollbar:   false,
+		contentCols: 0,
+	}
+}
+
+// Scrollbar draws a track and a thumb in a gutter on the right while
+// the content is taller than the viewport.
+func (u *ViewportUnit) Scrollbar() *ViewportUnit {
+	u.scrollbar = true
+	return u
+}
+
+// ContentCols lays the content out at cols instead of the viewport
+// width, so wider content can be scrolled horizontally.
+func (u *ViewportUnit) ContentCols(cols winsize.Cols) *ViewportUnit {
+	u.contentCols = cols
+	return u
+}
+
+func (u *ViewportUnit) ToUnit() drawable.Unit {
+	return drawable.NewBuilder().
+		Name(Name).
+		MergeTags(u.unit.Tags).
+		Init(u.init).
+		Wipe(u.unit.Drawable.Wipe).
+		Draw(u.draw).
+		ToUnit()
+}
+
+func (u *ViewportUnit) init() {
+	u.loaded = true
+	u.unit.Drawable.Init()
+}
+
+func (u *ViewportUnit) draw(size winsize.Winsize) ([]text.Line, bool) {
+	assert.True(u.loaded, drawable.MessageInitialized)
+
+	gutter := winsize.Cols(0)
+	if u.scrollbar && size.Cols > gutterCols {
+		gutter = gutterCols
+	}
+
+	view := winsize.New(size.Rows, size.Cols.Sub(gutter))
+
+	width := view.Cols
+	if u.contentCols > 0 {
+		width = u.contentCols
+	}
+
+	u.unit.Drawable.Wipe()
+	content := drain.UnitEager(winsize.New(size.Rows, width), u.unit)
+
+	u.state.update(view, uint(len(content)), text.MaxLineMeasure(width, content...))
+
+	top := min(u.state.Row(), uint(len(content)))
+	bottom := min(top+uint(view.Rows), uint(len(content)))
+
+	lines := content[top:bottom]
+
+	shift := u.state.Col() > 0 || width > view.Cols
+	if shift || gutter > 0 {
+		lines = u.frame(lines, view.Cols, width)
+	}
+
+	if gutter > 0 && uint(len(content)) > uint(view.Rows) {
+		u.drawScrollbar(lines, uint(len(content)))
+	}
+
+	return lines, false
+}
+
+// frame cuts the lines to the columns in view and pads them to its
+// width, so a scrollbar lines up on the right.
+func (u *ViewportUnit) frame(lines []text.Line, cols, width winsize.Cols) []text.Line {
+	spec := styler.NewDefaultSpec()
+
+	result := make([]text.Line, len(lines))
+	for i, line := range lines {
+		line = spec.Materialize(line, winsize.New(1, width))
+
+		from := u.state.Col()
+		line.Text = text.SliceCells(line.Text, from, from+cols)
+
+		measure := text.FragmentMeasure(cols, line.Text...)
+		if measure < cols {
+			line.PushFragments(*text.NewFragment(
+				strings.Repeat(marker.DefaultPaddingText, int(cols-measure)),
+			))
+		}
+
+		result[i] = line
+	}
+
+	return result
+}
+
+func (u *ViewportUnit) drawScrollbar(lines []text.Line, total uint) {
+	rows := uint(len(lines))
+	if rows == 0 {
+		return
+	}
+
+	thumb := max(1, rows*rows/total)
+	start := u.state.Row() * (rows - thumb) / (total - rows)
+
+	current := theme.Current()
+	track := current.Glyph(theme.GlyphScrollTrack)
+	grip := current.Glyph(theme.GlyphScrollThumb)
+
+	for i := range lines {
+		glyph, role := track, style.RoleScrollbar
+		if uint(i) >= start && uint(i) < start+thumb {
+			glyph, role = grip, style.RoleScrollThumb
+		}
+
+		lines[i].PushFragments(*text.NewFragment(glyph).SetRole(role))
+	}
+}
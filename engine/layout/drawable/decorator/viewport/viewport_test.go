@@ -0,0 +1,156 @@
+package viewport
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	assert "github.com/Rafael24595/go-assert/assert/test"
+
+	"github.com/Rafael24595/go-reacterm-core/engine/layout/drawable"
+	"github.com/Rafael24595/go-reacterm-core/engine/layout/drawable/primitive/line"
+	"github.com/Rafael24595/go-reacterm-core/engine/layout/drawable/spatial/stack"
+	"github.com/Rafael24595/go-reacterm-core/engine/model/winsize"
+	"github.com/Rafael24595/go-reacterm-core/engine/render/style"
+	"github.com/Rafael24595/go-reacterm-core/engine/render/text"
+
+	drawable_test "github.com/Rafael24595/go-reacterm-core/test/engine/layout/drawable"
+)
+
+func list(prefix string, count int) drawable.Unit {
+	lines := make([]text.Line, count)
+	for i := range lines {
+		lines[i] = *text.NewLine(fmt.Sprintf("%s%d", prefix, i))
+	}
+	return line.UnitFromLines(lines...)
+}
+
+func render(lines []text.Line) string {
+	result := make([]string, len(lines))
+	for i := range lines {
+		result[i] = text.LineToString(&lines[i])
+	}
+	return strings.Join(result, "\n")
+}
+
+func TestViewport_UnitBasicSuite(t *testing.T) {
+	mock := &drawable_test.MockUnit{}
+	unit := New(mock.ToUnit(), NewState()).ToUnit()
+	drawable_test.Test_UnitBasicSuite(t, unit)
+}
+
+func TestViewport_ShowsWindowAtOffset(t *testing.T) {
+	state := NewState()
+	unit := New(list("a", 10), state).ToUnit()
+
+	unit.Drawable.Init()
+
+	size := winsize.New(3, 5)
+
+	lines, hasNext := unit.Drawable.Draw(size)
+
+	assert.False(t, hasNext)
+	assert.Equal(t, "a0\na1\na2", render(lines))
+
+	state.ScrollDown(2)
+	lines, _ = unit.Drawable.Draw(size)
+
+	assert.Equal(t, "a2\na3\na4", render(lines))
+
+	state.Bottom()
+	lines, _ = unit.Drawable.Draw(size)
+
+	assert.Equal(t, "a7\na8\na9", render(lines))
+}
+
+func TestViewport_Scrollbar(t *testing.T) {
+	state := NewState()
+	unit := New(list("a", 8), state).Scrollbar().ToUnit()
+
+	unit.Drawable.Init()
+
+	size := winsize.New(4, 4)
+
+	lines, _ := unit.Drawable.Draw(size)
+
+	assert.Len(t, 4, lines)
+	for _, l := range lines {
+		assert.Equal(t, 4, int(text.FragmentMeasure(size.Cols, l.Text...)))
+	}
+
+	roles := func() []style.Role {
+		result := make([]style.Role, len(lines))
+		for i, l := range lines {
+			result[i] = l.Text[len(l.Text)-1].Role
+		}
+		return result
+	}
+
+	got := roles()
+	assert.Equal(t, style.RoleScrollThumb, got[0])
+	assert.Equal(t, style.RoleScrollThumb, got[1])
+	assert.Equal(t, style.RoleScrollbar, got[2])
+
+	state.Bottom()
+	lines, _ = unit.Drawable.Draw(size)
+
+	got = roles()
+	assert.Equal(t, style.RoleScrollbar, got[1])
+	assert.Equal(t, style.RoleScrollThumb, got[3])
+}
+
+func TestViewport_NoScrollbarWhenContentFits(t *testing.T) {
+	unit := New(list("a", 2), NewState()).Scrollbar().ToUnit()
+
+	unit.Drawable.Init()
+
+	lines, _ := unit.Drawable.Draw(winsize.New(4, 4))
+
+	assert.Equal(t, "a0 \na1 ", render(lines))
+}
+
+func TestViewport_HorizontalScroll(t *testing.T) {
+	state := NewState()
+	content := line.UnitFromLines(*text.NewLine("0123456789"))
+
+	unit := New(content, state).ContentCols(10).ToUnit()
+
+	unit.Drawable.Init()
+
+	size := winsize.New(1, 4)
+
+	lines, _ := unit.Drawable.Draw(size)
+	assert.Equal(t, "0123", render(lines))
+
+	state.ScrollRight(3)
+	lines, _ = unit.Drawable.Draw(size)
+	assert.Equal(t, "3456", render(lines))
+
+	state.ScrollRight(100)
+	lines, _ = unit.Drawable.Draw(size)
+	assert.Equal(t, "6789", render(lines))
+}
+
+func TestViewport_IndependentInHStack(t *testing.T) {
+	left := NewState()
+	right := NewState()
+
+	unit := stack.NewHStack(
+		New(list("l", 10), left).ToUnit(),
+		New(list("r", 10), right).ToUnit(),
+	).ToUnit()
+
+	unit.Drawable.Init()
+
+	size := winsize.New(2, 8)
+	unit.Drawable.Draw(size)
+
+	right.ScrollDown(5)
+
+	unit.Drawable.Wipe()
+	unit.Drawable.Init()
+
+	lines, _ := unit.Drawable.Draw(size)
+
+	assert.Equal(t, "l0r5\nl1r6", render(lines))
+}
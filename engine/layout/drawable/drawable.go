@@ -9,10 +9,15 @@ type InitFunc func()
 type WipeFunc func()
 type DrawFunc func(size winsize.Winsize) ([]text.Line, bool)
 
+// MeasureFunc tells the size the content of a unit would take within
+// size. It is optional, and asked for by layouts that size by content.
+type MeasureFunc func(size winsize.Winsize) winsize.Winsize
+
 type Drawable struct {
-	Init InitFunc
-	Wipe WipeFunc
-	Draw DrawFunc
+	Init    InitFunc
+	Wipe    WipeFunc
+	Draw    DrawFunc
+	Measure MeasureFunc
 }
 
 func IsZeroDrawable(drawable Drawable) bool {
@@ -49,6 +49,7 @@ func (u *LineUnit) ToUnit() drawable.Unit {
 		Init(u.init).
 		Wipe(u.wipe).
 		Draw(u.draw).
+		Measure(u.measure).
 		ToUnit()
 }
 
@@ -83,6 +84,25 @@ func (u *LineUnit) draw(size winsize.Winsize) ([]text.Line, bool) {
 	return result, len(u.source) > 0
 }
 
+func (u *LineUnit) measure(size winsize.Winsize) winsize.Winsize {
+	lines := u.lines
+	if !u.loaded {
+		lines = u.normalizer()
+	}
+
+	cols := winsize.Cols(0)
+	for _, l := range lines {
+		if l.Source == nil {
+			continue
+		}
+		cols = max(cols, text.FragmentMeasure(size.Cols, l.Source.Text...))
+	}
+
+	rows := min(size.Rows, winsize.Rows(len(lines)))
+
+	return winsize.New(rows, min(size.Cols, cols))
+}
+
 func (u *LineUnit) nextIndexedWrappedLine(size winsize.Winsize) (*text.Line, []wrap.LayoutLine) {
 	if u.indexMeta == nil {
 		return wrap.NextLine(size.Cols, u.source)
@@ -3,6 +3,11 @@ package line
 import (
 	"testing"
 
+	assert "github.com/Rafael24595/go-assert/assert/test"
+
+	"github.com/Rafael24595/go-reacterm-core/engine/model/winsize"
+	"github.com/Rafael24595/go-reacterm-core/engine/render/text"
+
 	drawable_test "github.com/Rafael24595/go-reacterm-core/test/engine/layout/drawable"
 )
 
@@ -10,3 +15,13 @@ func TestLine_UnitBasicSuite(t *testing.T) {
 	unit := UnitFromLines()
 	drawable_test.Test_UnitBasicSuite(t, unit)
 }
+
+func TestLine_Measure(t *testing.T) {
+	unit := UnitFromLines(
+		*text.NewLine("go"),
+		*text.NewLine("golang"),
+	)
+
+	assert.Equal(t, winsize.New(2, 6), unit.Drawable.Measure(winsize.New(10, 20)))
+	assert.Equal(t, winsize.New(1, 4), unit.Drawable.Measure(winsize.New(1, 4)))
+}
@@ -0,0 +1,58 @@
+package grid
+
+import (
+	"github.com/Rafael24595/go-reacterm-core/engine/layout/drawable"
+)
+
+type CellOption func(*cell)
+
+type cell struct {
+	unit    drawable.Unit
+	row     uint16
+	col     uint16
+	rowSpan uint16
+	colSpan uint16
+	status  bool
+}
+
+func newCell(unit drawable.Unit, row, col uint16, opts ...CellOption) cell {
+	c := cell{
+		unit:    unit,
+		row:     row,
+		col:     col,
+		rowSpan: 1,
+		colSpan: 1,
+		status:  true,
+	}
+
+	for _, opt := range opts {
+		opt(&c)
+	}
+
+	return c
+}
+
+func RowSpan(span uint16) CellOption {
+	return func(c *cell) {
+		c.rowSpan = max(1, span)
+	}
+}
+
+func ColSpan(span uint16) CellOption {
+	return func(c *cell) {
+		c.colSpan = max(1, span)
+	}
+}
+
+func (c cell) lastRow() uint16 {
+	return c.row + c.rowSpan - 1
+}
+
+func (c cell) lastCol() uint16 {
+	return c.col + c.colSpan - 1
+}
+
+func (c cell) overlaps(other cell) bool {
+	return c.row <= other.lastRow() && other.row <= c.lastRow() &&
+		c.col <= other.lastCol() && other.col <= c.lastCol()
+}
@@ -0,0 +1,278 @@
+package grid
+
+import (
+	"slices"
+	"strings"
+
+	assert "github.com/Rafael24595/go-assert/assert/runtime"
+
+	"github.com/Rafael24595/go-reacterm-core/engine/commons/structure/set"
+	"github.com/Rafael24595/go-reacterm-core/engine/helper/math"
+	"github.com/Rafael24595/go-reacterm-core/engine/layout/drawable"
+	"github.com/Rafael24595/go-reacterm-core/engine/layout/transform/drain"
+	"github.com/Rafael24595/go-reacterm-core/engine/model/winsize"
+	"github.com/Rafael24595/go-reacterm-core/engine/render/marker"
+	"github.com/Rafael24595/go-reacterm-core/engine/render/sink"
+	"github.com/Rafael24595/go-reacterm-core/engine/render/text"
+)
+
+const Name = "grid_unit"
+
+const MessageOverlap = "grid cells should not overlap"
+
+// GridUnit lays its children out on rows and columns shared by every
+// cell, so cells line up across the whole grid. Tracks that are used
+// by a cell but not defined are fractional with a weight of one.
+type GridUnit struct {
+	loaded     bool
+	lazyLoaded bool
+	rows       []Track[winsize.Rows]
+	cols       []Track[winsize.Cols]
+	rowGap     winsize.Rows
+	colGap     winsize.Cols
+	cells      []cell
+}
+
+func New() *GridUnit {
+	return &GridUnit{
+		loaded:     false,
+		lazyLoaded: false,
+		rows:       make([]Track[winsize.Rows], 0),
+		cols:       make([]Track[winsize.Cols], 0),
+		rowGap:     0,
+		colGap:     0,
+		cells:      make([]cell, 0),
+	}
+}
+
+func (u *GridUnit) Rows(tracks ...Track[winsize.Rows]) *GridUnit {
+	assert.False(u.loaded, drawable.MessageNewElement)
+
+	u.rows = append(u.rows, tracks...)
+	return u
+}
+
+func (u *GridUnit) Cols(tracks ...Track[winsize.Cols]) *GridUnit {
+	assert.False(u.loaded, drawable.MessageNewElement)
+
+	u.cols = append(u.cols, tracks...)
+	return u
+}
+
+func (u *GridUnit) Gap(rows winsize.Rows, cols winsize.Cols) *GridUnit {
+	u.rowGap = rows
+	u.colGap = cols
+	return u
+}
+
+// Place puts unit in the cell at row and col, counted from zero.
+func (u *GridUnit) Place(unit drawable.Unit, row, col uint16, opts ...CellOption) *GridUnit {
+	assert.False(u.loaded, drawable.MessageNewElement)
+
+	item := newCell(unit, row, col, opts...)
+	for _, other := range u.cells {
+		assert.False(item.overlaps(other), MessageOverlap)
+	}
+
+	u.cells = append(u.cells, item)
+	slices.SortStableFunc(u.cells, func(a, b cell) int {
+		return int(a.col) - int(b.col)
+	})
+
+	return u
+}
+
+func (u *GridUnit) Size() uint {
+	return uint(len(u.cells))
+}
+
+func (u *GridUnit) Units() []drawable.Unit {
+	units := make([]drawable.Unit, len(u.cells))
+	for i := range u.cells {
+		units[i] = u.cells[i].unit
+	}
+	return units
+}
+
+func (u *GridUnit) ToUnit() drawable.Unit {
+	return drawable.NewBuilder().
+		Name(Name).
+		MergeTags(u.tags()).
+		Init(u.init).
+		Wipe(u.wipe).
+		Draw(u.draw).
+		ToUnit()
+}
+
+func (u *GridUnit) tags() set.Set[string] {
+	tags := set.NewSet[string]()
+	for i := range u.cells {
+		tags.Merge(u.cells[i].unit.Tags)
+	}
+	return tags
+}
+
+func (u *GridUnit) init() {
+	u.loaded = true
+	u.lazyLoaded = false
+}
+
+func (u *GridUnit) lazyInit() {
+	if u.lazyLoaded {
+		return
+	}
+
+	u.lazyLoaded = true
+
+	for i := range u.cells {
+		u.cells[i].unit.Drawable.Init()
+		u.cells[i].status = true
+	}
+}
+
+func (u *GridUnit) wipe() {
+	u.lazyLoaded = false
+
+	for i := range u.cells {
+		u.cells[i].unit.Drawable.Wipe()
+		u.cells[i].status = true
+	}
+}
+
+func (u *GridUnit) draw(size winsize.Winsize) ([]text.Line, bool) {
+	assert.True(u.loaded, drawable.MessageInitialized)
+
+	u.lazyInit()
+
+	rowSizes := resolve(u.rowTracks(), size.Rows, u.rowGap)
+	colSizes := resolve(u.colTracks(), size.Cols, u.colGap)
+
+	rowOffsets := offsets(rowSizes, u.rowGap)
+	colOffsets := offsets(colSizes, u.colGap)
+
+	height := winsize.Rows(0)
+	if len(rowSizes) > 0 {
+		last := len(rowSizes) - 1
+		height = min(size.Rows, rowOffsets[last]+rowSizes[last])
+	}
+
+	lines := make([]text.Line, height)
+	for i := range lines {
+		lines[i] = *text.EmptyLine()
+	}
+
+	widths := make([]winsize.Cols, height)
+	for i := range u.cells {
+		c := &u.cells[i]
+
+		top := rowOffsets[c.row]
+		rows := span(rowSizes, c.row, c.rowSpan, u.rowGap)
+
+		left := colOffsets[c.col]
+		cols := span(colSizes, c.col, c.colSpan, u.colGap)
+
+		if rows == 0 || cols == 0 {
+			c.status = false
+			continue
+		}
+
+		var content []text.Line
+		if c.status {
+			content, c.status = drain.UnitLazy(winsize.New(rows, cols), c.unit)
+		}
+
+		for j := range rows {
+			row := top + j
+			if row >= height {
+				break
+			}
+
+			var line *text.Line
+			if int(j) < len(content) {
+				line = &content[j]
+			}
+
+			u.place(&lines[row], &widths[row], line, left, cols)
+		}
+	}
+
+	return lines, u.hasNext()
+}
+
+// place writes a cell line into the grid row at left, padding both the
+// space before it and the line itself so the next cell stays aligned.
+func (u *GridUnit) place(row *text.Line, width *winsize.Cols, line *text.Line, left, cols winsize.Cols) {
+	if *width < left {
+		row.PushFragments(blank(left - *width))
+		*width = left
+	}
+
+	measure := winsize.Cols(0)
+	if line != nil {
+		result := sink.ApplySinks(line, cols).
+			SpreadHit()
+		if row.Hit == nil {
+			row.Hit = result.Hit
+		}
+
+		row.PushFragments(result.Text...)
+		measure = text.FragmentMeasure(cols, result.Text...)
+	}
+
+	if measure < cols {
+		row.PushFragments(blank(cols - measure))
+	}
+
+	*width = left + cols
+}
+
+func (u *GridUnit) rowTracks() []Track[winsize.Rows] {
+	count := len(u.rows)
+	for _, c := range u.cells {
+		count = max(count, int(c.lastRow())+1)
+	}
+	return fillTracks(u.rows, count)
+}
+
+func (u *GridUnit) colTracks() []Track[winsize.Cols] {
+	count := len(u.cols)
+	for _, c := range u.cells {
+		count = max(count, int(c.lastCol())+1)
+	}
+	return fillTracks(u.cols, count)
+}
+
+func (u *GridUnit) HasNext() bool {
+	return u.hasNext()
+}
+
+func (u *GridUnit) hasNext() bool {
+	for _, c := range u.cells {
+		if c.status {
+			return true
+		}
+	}
+	return false
+}
+
+func fillTracks[T math.Number](tracks []Track[T], count int) []Track[T] {
+	result := slices.Clone(tracks)
+	for len(result) < count {
+		result = append(result, Fraction[T](1))
+	}
+	return result
+}
+
+// span measures a cell covering count tracks from start, including
+// the gaps between them.
+func span[T math.Number](sizes []T, start, count uint16, gap T) T {
+	total := T(0)
+	for i := range count {
+		total += sizes[start+i]
+	}
+	return total + gap*T(count-1)
+}
+
+func blank(cols winsize.Cols) text.Fragment {
+	return *text.NewFragment(strings.Repeat(marker.DefaultPaddingText, int(cols)))
+}
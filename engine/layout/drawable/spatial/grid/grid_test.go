@@ -0,0 +1,153 @@
+package grid
+
+import (
+	"strings"
+	"testing"
+
+	assert "github.com/Rafael24595/go-assert/assert/test"
+
+	"github.com/Rafael24595/go-reacterm-core/engine/layout/drawable"
+	"github.com/Rafael24595/go-reacterm-core/engine/model/winsize"
+	"github.com/Rafael24595/go-reacterm-core/engine/render/text"
+
+	drawable_test "github.com/Rafael24595/go-reacterm-core/test/engine/layout/drawable"
+)
+
+func mock(lines ...string) drawable.Unit {
+	m := &drawable_test.MockUnit{}
+	for _, l := range lines {
+		m.Lines = append(m.Lines, *text.NewLine(l))
+	}
+	return m.ToUnit()
+}
+
+func render(lines []text.Line) string {
+	result := make([]string, len(lines))
+	for i := range lines {
+		result[i] = text.LineToString(&lines[i])
+	}
+	return strings.Join(result, "\n")
+}
+
+func TestGrid_UnitBasicSuite(t *testing.T) {
+	unit := New().Place(mock(), 0, 0).ToUnit()
+	drawable_test.Test_UnitBasicSuite(t, unit)
+}
+
+func TestGrid_AlignsColumnsAcrossRows(t *testing.T) {
+	unit := New().
+		Rows(Fixed[winsize.Rows](1), Fixed[winsize.Rows](1)).
+		Cols(Fixed[winsize.Cols](4), Fraction[winsize.Cols](1)).
+		Place(mock("a"), 0, 0).
+		Place(mock("b"), 0, 1).
+		Place(mock("cpu"), 1, 0).
+		Place(mock("d"), 1, 1).
+		ToUnit()
+
+	unit.Drawable.Init()
+
+	lines, hasNext := unit.Drawable.Draw(winsize.New(5, 8))
+
+	assert.False(t, hasNext)
+	assert.Equal(t, "a   b   \ncpu d   ", render(lines))
+}
+
+func TestGrid_Gaps(t *testing.T) {
+	unit := New().
+		Rows(Fixed[winsize.Rows](1), Fixed[winsize.Rows](1)).
+		Cols(Fixed[winsize.Cols](2), Fixed[winsize.Cols](2)).
+		Gap(1, 1).
+		Place(mock("a"), 0, 0).
+		Place(mock("b"), 0, 1).
+		Place(mock("c"), 1, 1).
+		ToUnit()
+
+	unit.Drawable.Init()
+
+	lines, _ := unit.Drawable.Draw(winsize.New(5, 10))
+
+	assert.Equal(t, "a  b \n\n   c ", render(lines))
+}
+
+func TestGrid_Spans(t *testing.T) {
+	unit := New().
+		Rows(Fixed[winsize.Rows](1), Fixed[winsize.Rows](1)).
+		Cols(Fixed[winsize.Cols](2), Fixed[winsize.Cols](2)).
+		Gap(0, 1).
+		Place(mock("ab", "cd"), 0, 0, RowSpan(2)).
+		Place(mock("x"), 0, 1).
+		ToUnit()
+
+	unit.Drawable.Init()
+
+	lines, _ := unit.Drawable.Draw(winsize.New(5, 10))
+
+	assert.Equal(t, "ab x \ncd", render(lines))
+
+	wide := New().
+		Cols(Fixed[winsize.Cols](2), Fixed[winsize.Cols](2)).
+		Gap(0, 1).
+		Place(mock("title"), 0, 0, ColSpan(2)).
+		Place(mock("a"), 1, 0).
+		Place(mock("b"), 1, 1).
+		ToUnit()
+
+	wide.Drawable.Init()
+
+	lines, _ = wide.Drawable.Draw(winsize.New(2, 10))
+
+	assert.Equal(t, "title\na  b ", render(lines))
+}
+
+func TestGrid_ImplicitTracksFillArea(t *testing.T) {
+	unit := New().
+		Place(mock("a"), 0, 0).
+		Place(mock("b"), 0, 1).
+		ToUnit()
+
+	unit.Drawable.Init()
+
+	lines, _ := unit.Drawable.Draw(winsize.New(2, 6))
+
+	assert.Equal(t, "a  b  \n      ", render(lines))
+}
+
+func TestGrid_Pagination(t *testing.T) {
+	long := &drawable_test.MockUnit{
+		Lines: []text.Line{
+			*text.NewLine("1"),
+			*text.NewLine("2"),
+			*text.NewLine("3"),
+			*text.NewLine("4"),
+		},
+		Batch: 2,
+	}
+
+	unit := New().
+		Rows(Fixed[winsize.Rows](2)).
+		Place(long.ToUnit(), 0, 0).
+		Place(mock("x"), 0, 1).
+		ToUnit()
+
+	unit.Drawable.Init()
+
+	size := winsize.New(2, 4)
+
+	lines, hasNext := unit.Drawable.Draw(size)
+
+	assert.True(t, hasNext)
+	assert.Equal(t, "1 x \n2   ", render(lines))
+
+	lines, hasNext = unit.Drawable.Draw(size)
+
+	assert.False(t, hasNext)
+	assert.Equal(t, "3   \n4   ", render(lines))
+}
+
+func TestGrid_OverlapPanics(t *testing.T) {
+	assert.Panic(t, func() {
+		New().
+			Place(mock("a"), 0, 0, ColSpan(2)).
+			Place(mock("b"), 0, 1)
+	})
+}
@@ -0,0 +1,97 @@
+package grid
+
+import (
+	"github.com/Rafael24595/go-reacterm-core/engine/config/chunk"
+	"github.com/Rafael24595/go-reacterm-core/engine/helper/math"
+)
+
+// Track sizes a row or a column of the grid. Fixed and percent tracks
+// are sized first; fractional tracks share what is left by weight.
+type Track[T math.Number] struct {
+	chunk    chunk.Chunk[T]
+	fraction T
+}
+
+func Fixed[T math.Number](size T) Track[T] {
+	return Track[T]{
+		chunk:    chunk.Fixed(size),
+		fraction: 0,
+	}
+}
+
+func Percent[T math.Number](size T) Track[T] {
+	return Track[T]{
+		chunk:    chunk.Percent(size),
+		fraction: 0,
+	}
+}
+
+func Fraction[T math.Number](weight T) Track[T] {
+	return Track[T]{
+		chunk:    chunk.Dynamic[T](),
+		fraction: max(1, weight),
+	}
+}
+
+// resolve sizes the tracks within total, leaving gap between each pair.
+// Percent tracks are relative to the space left once gaps are taken.
+func resolve[T math.Number](tracks []Track[T], total, gap T) []T {
+	sizes := make([]T, len(tracks))
+	if len(tracks) == 0 {
+		return sizes
+	}
+
+	gaps := min(total, gap*T(len(tracks)-1))
+	available := total - gaps
+	remaining := available
+
+	weights := 0
+	for i, track := range tracks {
+		if !track.chunk.Sized {
+			weights += int(track.fraction)
+			continue
+		}
+
+		sizes[i] = min(remaining, track.chunk.Adapter(available))
+		remaining -= sizes[i]
+	}
+
+	if weights == 0 {
+		return sizes
+	}
+
+	shared := T(0)
+	for i, track := range tracks {
+		if track.chunk.Sized {
+			continue
+		}
+
+		sizes[i] = T(int(remaining) * int(track.fraction) / weights)
+		shared += sizes[i]
+	}
+
+	rest := remaining - shared
+	for i := 0; rest > 0; i = (i + 1) % len(tracks) {
+		if tracks[i].chunk.Sized {
+			continue
+		}
+
+		sizes[i] += 1
+		rest -= 1
+	}
+
+	return sizes
+}
+
+// offsets returns where each track starts, given the sizes and gap.
+func offsets[T math.Number](sizes []T, gap T) []T {
+	result := make([]T, len(sizes))
+
+	position := T(0)
+	for i, size := range sizes {
+		result[i] = position
+		position += size + gap
+	}
+
+	return result
+}
@@ -0,0 +1,77 @@
+package grid
+
+import (
+	"testing"
+
+	assert "github.com/Rafael24595/go-assert/assert/test"
+
+	"github.com/Rafael24595/go-reacterm-core/engine/model/winsize"
+)
+
+func TestResolve_Fixed(t *testing.T) {
+	tracks := []Track[winsize.Cols]{Fixed[winsize.Cols](4), Fixed[winsize.Cols](6)}
+
+	sizes := resolve(tracks, 20, 0)
+
+	assert.Equal(t, 4, sizes[0])
+	assert.Equal(t, 6, sizes[1])
+}
+
+func TestResolve_PercentAfterGaps(t *testing.T) {
+	tracks := []Track[winsize.Cols]{Percent[winsize.Cols](50), Percent[winsize.Cols](50)}
+
+	sizes := resolve(tracks, 22, 2)
+
+	assert.Equal(t, 10, sizes[0])
+	assert.Equal(t, 10, sizes[1])
+}
+
+func TestResolve_FractionsShareRest(t *testing.T) {
+	tracks := []Track[winsize.Cols]{
+		Fixed[winsize.Cols](10),
+		Fraction[winsize.Cols](1),
+		Fraction[winsize.Cols](2),
+	}
+
+	sizes := resolve(tracks, 41, 1)
+
+	assert.Equal(t, 10, sizes[0])
+	assert.Equal(t, 10, sizes[1])
+	assert.Equal(t, 19, sizes[2])
+}
+
+func TestResolve_FractionsSpreadRemainder(t *testing.T) {
+	tracks := []Track[winsize.Cols]{
+		Fraction[winsize.Cols](1),
+		Fraction[winsize.Cols](1),
+		Fraction[winsize.Cols](1),
+	}
+
+	sizes := resolve(tracks, 11, 0)
+
+	assert.Equal(t, 4, sizes[0])
+	assert.Equal(t, 4, sizes[1])
+	assert.Equal(t, 3, sizes[2])
+}
+
+func TestResolve_ClampsOverflow(t *testing.T) {
+	tracks := []Track[winsize.Cols]{
+		Fixed[winsize.Cols](8),
+		Fixed[winsize.Cols](8),
+		Fraction[winsize.Cols](1),
+	}
+
+	sizes := resolve(tracks, 10, 0)
+
+	assert.Equal(t, 8, sizes[0])
+	assert.Equal(t, 2, sizes[1])
+	assert.Equal(t, 0, sizes[2])
+}
+
+func TestOffsets(t *testing.T) {
+	got := offsets([]winsize.Cols{3, 4, 5}, 1)
+
+	assert.Equal(t, 0, got[0])
+	assert.Equal(t, 4, got[1])
+	assert.Equal(t, 9, got[2])
+}
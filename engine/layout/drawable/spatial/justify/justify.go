@@ -5,6 +5,7 @@ import (
 
 	"github.com/Rafael24595/go-reacterm-core/engine/helper/math"
 	"github.com/Rafael24595/go-reacterm-core/engine/layout/drawable"
+	"github.com/Rafael24595/go-reacterm-core/engine/model/hit"
 	"github.com/Rafael24595/go-reacterm-core/engine/model/winsize"
 	"github.com/Rafael24595/go-reacterm-core/engine/render/marker"
 	"github.com/Rafael24595/go-reacterm-core/engine/render/style"
@@ -21,6 +22,7 @@ const Name = "justify_unit"
 
 type JustifyUnit struct {
 	loaded    bool
+	target    string
 	maxOpts   uint16
 	maxCols   winsize.Cols
 	justify   style.Justify
@@ -31,6 +33,7 @@ type JustifyUnit struct {
 func New(frags []text.Fragment) *JustifyUnit {
 	return &JustifyUnit{
 		loaded:    false,
+		target:    "",
 		maxOpts:   style.DefaultMaxOpts,
 		justify:   style.JustifyAround,
 		fragments: frags,
@@ -42,6 +45,11 @@ func UnitFromFragments(frags []text.Fragment) drawable.Unit {
 	return New(frags).ToUnit()
 }
 
+func (u *JustifyUnit) Target(owner string) *JustifyUnit {
+	u.target = owner
+	return u
+}
+
 func (u *JustifyUnit) MaxOpts(opts uint16) *JustifyUnit {
 	u.maxOpts = max(1, opts)
 	return u
@@ -91,6 +99,7 @@ func (u *JustifyUnit) draw(size winsize.Winsize) ([]text.Line, bool) {
 	maxOpts := int(u.maxOpts)
 	maxCols := math.MinNotZero(size.Cols, u.maxCols)
 
+	start := u.cursor
 	remaining := winsize.Cols(0)
 	frags := make([]text.Fragment, 0)
 
@@ -108,7 +117,11 @@ func (u *JustifyUnit) draw(size winsize.Winsize) ([]text.Line, bool) {
 		newRemaining := remaining + spacing + fragSize
 		if fragsLen > 0 && fragsLen >= maxOpts || newRemaining > maxCols {
 			line := justifyLine(maxCols, frags, remaining, u.justify)
-			return []text.Line{*line}, true
+			return []text.Line{*u.targetLine(line, start)}, true
+		}
+
+		if u.target != "" {
+			frag.Hit = hit.New(u.target, i)
 		}
 
 		remaining = newRemaining
@@ -118,7 +131,25 @@ func (u *JustifyUnit) draw(size winsize.Winsize) ([]text.Line, bool) {
 	}
 
 	line := justifyLine(maxCols, frags, remaining, u.justify)
-	return []text.Line{*line}, u.cursor < uint16(len(u.fragments))
+	return []text.Line{*u.targetLine(line, start)}, u.cursor < uint16(len(u.fragments))
+}
+
+// targetLine gives each gap the target of the option before it, so a
+// click resolves to the option under or just left of the cursor.
+func (u *JustifyUnit) targetLine(line *text.Line, start uint16) *text.Line {
+	if u.target == "" {
+		return line
+	}
+
+	last := hit.New(u.target, start)
+	for i := range line.Text {
+		if line.Text[i].Hit == nil {
+			line.Text[i].Hit = last
+		}
+		last = line.Text[i].Hit
+	}
+
+	return line.SetHit(hit.New(u.target, start))
 }
 
 func justifyLine(cols winsize.Cols, frags []text.Fragment, size winsize.Cols, mode style.Justify) *text.Line {
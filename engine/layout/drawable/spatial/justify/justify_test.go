@@ -10,6 +10,7 @@ import (
 	"github.com/Rafael24595/go-reacterm-core/engine/helper"
 	"github.com/Rafael24595/go-reacterm-core/engine/model/winsize"
 	"github.com/Rafael24595/go-reacterm-core/engine/render/style"
+	"github.com/Rafael24595/go-reacterm-core/engine/render/styler"
 	"github.com/Rafael24595/go-reacterm-core/engine/render/text"
 
 	drawable_test "github.com/Rafael24595/go-reacterm-core/test/engine/layout/drawable"
@@ -216,3 +217,21 @@ func TestJustifyLine_Evenly(t *testing.T) {
 
 	assert.Equal(t, "  aa    bb    cc  ", renderLine(18, style.JustifyEvenly, *line))
 }
+
+func TestJustify_TargetsEachOption(t *testing.T) {
+	unit := New(text.FragmentsFromString("aa", "bb", "cc")).
+		Target("menu").
+		Justify(style.JustifyStart).
+		ToUnit()
+
+	unit.Drawable.Init()
+	lines, _ := unit.Drawable.Draw(winsize.New(1, 20))
+
+	line := styler.NewDefaultSpec().Materialize(lines[0], winsize.New(1, 20))
+
+	assert.Equal(t, 0, line.HitAt(0).Index)
+	assert.Equal(t, 0, line.HitAt(2).Index)
+	assert.Equal(t, 1, line.HitAt(3).Index)
+	assert.Equal(t, 2, line.HitAt(7).Index)
+	assert.True(t, line.HitAt(7).Is("menu"))
+}
@@ -285,7 +285,8 @@ func (u *HStackUnit) makeLines(blocks []block) []text.Line {
 			}
 
 			l := b.lines[i]
-			result := sink.ApplySinks(&l, b.size.Cols)
+			result := sink.ApplySinks(&l, b.size.Cols).
+				SpreadHit()
 
 			line.CopyMeta(result)
 			line.PushFragments(result.Text...)
@@ -298,28 +299,15 @@ func (u *HStackUnit) makeLines(blocks []block) []text.Line {
 
 func (u *HStackUnit) fixLayout(size winsize.Winsize) []layer.Layer[winsize.Cols] {
 	layers := make([]layer.Layer[winsize.Cols], 0, len(u.fixed))
-	available, rest := u.calcSpace(size)
-
 	for _, item := range u.fixed {
-		if !item.Status {
-			continue
-		}
-
-		chk := item.Chunk()
-
-		chunk := winsize.Cols(0)
-		if chk.Sized {
-			chunk = min(size.Cols, chk.Adapter(size.Cols))
-		} else {
-			chunk = available
-			if rest > 0 {
-				chunk += 1
-				rest -= 1
-			}
+		if item.Status {
+			layers = append(layers, item)
 		}
+	}
 
-		item := layer.FromLayer(item, layer.WithValue(chunk))
-		layers = append(layers, item)
+	sizes := layer.Solve(layers, size.Cols, measureCols(size))
+	for i := range layers {
+		layers[i] = layer.FromLayer(layers[i], layer.WithValue(sizes[i]))
 	}
 
 	assert.LazyTrue(func() bool {
@@ -329,26 +317,6 @@ func (u *HStackUnit) fixLayout(size winsize.Winsize) []layer.Layer[winsize.Cols]
 	return layers
 }
 
-func (u *HStackUnit) calcSpace(size winsize.Winsize) (winsize.Cols, winsize.Cols) {
-	cols, zeroes := u.countCols(size)
-
-	assert.True(cols <= size.Cols, drawable.MessageNewElement, size.Cols)
-
-	if zeroes == 0 {
-		return 0, 0
-	}
-
-	cols = min(size.Cols, cols)
-	remaining := size.Cols.Sub(cols)
-
-	cZeroes := winsize.Cols(zeroes)
-
-	available := remaining / cZeroes
-	rest := remaining % cZeroes
-
-	return available, rest
-}
-
 func (u *HStackUnit) HasNext() bool {
 	items := u.items
 	if u.lazyLoaded {
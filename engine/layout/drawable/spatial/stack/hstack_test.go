@@ -5,8 +5,12 @@ import (
 
 	assert "github.com/Rafael24595/go-assert/assert/test"
 
+	"github.com/Rafael24595/go-reacterm-core/engine/config/chunk"
 	"github.com/Rafael24595/go-reacterm-core/engine/config/layer"
+	"github.com/Rafael24595/go-reacterm-core/engine/layout/drawable/primitive/line"
+	"github.com/Rafael24595/go-reacterm-core/engine/model/hit"
 	"github.com/Rafael24595/go-reacterm-core/engine/model/winsize"
+	"github.com/Rafael24595/go-reacterm-core/engine/render/styler"
 	"github.com/Rafael24595/go-reacterm-core/engine/render/text"
 
 	drawable_test "github.com/Rafael24595/go-reacterm-core/test/engine/layout/drawable"
@@ -147,3 +151,71 @@ func TestHStack_RenderOutput(t *testing.T) {
 	assert.Equal(t, "lanlan", text.LineToString(&lines[1]))
 	assert.Equal(t, "gg", text.LineToString(&lines[2]))
 }
+
+func TestHStack_FlexSidebar(t *testing.T) {
+	sidebar := &drawable_test.MockUnit{}
+	main := &drawable_test.MockUnit{}
+
+	stack := NewHStack()
+	stack.PushLayer(
+		sidebar.ToUnit(),
+		layer.Min[winsize.Cols](20),
+		layer.WithMax(chunk.Percent[winsize.Cols](30)),
+		layer.Grow[winsize.Cols](0),
+	)
+	stack.Push(main.ToUnit())
+
+	stack.init()
+	stack.lazyInit(winsize.Winsize{
+		Cols: 50,
+	})
+
+	assert.Equal(t, 20, stack.fixed[0].Value)
+	assert.Equal(t, 30, stack.fixed[1].Value)
+
+	stack.fixed = stack.fixLayout(winsize.Winsize{
+		Cols: 200,
+	})
+
+	assert.Equal(t, 20, stack.fixed[0].Value)
+	assert.Equal(t, 180, stack.fixed[1].Value)
+}
+
+func TestHStack_ContentSizing(t *testing.T) {
+	label := line.UnitFromLines(*text.NewLine("name:"))
+	value := &drawable_test.MockUnit{}
+
+	stack := NewHStack()
+	stack.PushLayer(label, layer.Content[winsize.Cols]())
+	stack.Push(value.ToUnit())
+
+	stack.init()
+	stack.lazyInit(winsize.Winsize{
+		Cols: 40,
+	})
+
+	assert.Equal(t, 5, stack.fixed[0].Value)
+	assert.Equal(t, 35, stack.fixed[1].Value)
+}
+
+func TestHStack_TargetsByColumn(t *testing.T) {
+	left := line.UnitFromLines(
+		*text.NewLine("left").SetHit(hit.New("left", 0)),
+	)
+	right := line.UnitFromLines(
+		*text.NewLine("right").SetHit(hit.New("right", 0)),
+	)
+
+	stack := NewHStack().
+		PushLayer(left, layer.Fixed[winsize.Cols](10)).
+		PushLayer(right).
+		ToUnit()
+
+	stack.Drawable.Init()
+	lines, _ := stack.Drawable.Draw(winsize.New(1, 20))
+
+	row := styler.NewDefaultSpec().Materialize(lines[0], winsize.New(1, 20))
+
+	assert.True(t, row.HitAt(2).Is("left"))
+	assert.True(t, row.HitAt(12).Is("right"))
+}
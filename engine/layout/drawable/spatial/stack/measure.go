@@ -0,0 +1,25 @@
+package stack
+
+import (
+	"github.com/Rafael24595/go-reacterm-core/engine/config/layer"
+	"github.com/Rafael24595/go-reacterm-core/engine/layout/drawable"
+	"github.com/Rafael24595/go-reacterm-core/engine/model/winsize"
+)
+
+func measureCols(size winsize.Winsize) layer.MeasureFunc[winsize.Cols] {
+	return func(unit drawable.Unit) winsize.Cols {
+		if unit.Drawable.Measure == nil {
+			return 0
+		}
+		return unit.Drawable.Measure(size).Cols
+	}
+}
+
+func measureRows(size winsize.Winsize) layer.MeasureFunc[winsize.Rows] {
+	return func(unit drawable.Unit) winsize.Rows {
+		if unit.Drawable.Measure == nil {
+			return 0
+		}
+		return unit.Drawable.Measure(size).Rows
+	}
+}
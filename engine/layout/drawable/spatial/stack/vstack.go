@@ -198,7 +198,7 @@ func (u *VStackUnit) makeLines(size winsize.Winsize) ([]text.Line, bool) {
 		}
 
 		rows := remaining
-		if u.fixed[i].Chunk().Sized {
+		if u.fixed[i].Sized() {
 			value := u.fixed[i].Value
 			rows = min(value, remaining)
 		}
@@ -211,7 +211,7 @@ func (u *VStackUnit) makeLines(size winsize.Winsize) ([]text.Line, bool) {
 		}
 
 		linesLen := winsize.Rows(len(lines))
-		if linesLen < rows && u.fixed[i].Chunk().Sized {
+		if linesLen < rows && u.fixed[i].Sized() {
 			padded := make([]text.Line, rows)
 			copy(padded, lines)
 			lines = padded
@@ -227,24 +227,18 @@ func (u *VStackUnit) makeLines(size winsize.Winsize) ([]text.Line, bool) {
 
 func (u *VStackUnit) fixLayout(size winsize.Winsize) []layer.Layer[winsize.Rows] {
 	layers := make([]layer.Layer[winsize.Rows], 0, len(u.fixed))
-
-	statics := 0
 	for _, item := range u.fixed {
-		if !item.Status {
-			continue
-		}
-
-		chk := item.Chunk()
-
-		chunk := winsize.Rows(0)
-		if chk.Sized {
-			chunk = min(size.Rows, chk.Adapter(size.Rows))
+		if item.Status {
+			layers = append(layers, item)
 		}
+	}
 
-		item := layer.FromLayer(item, layer.WithValue(chunk))
-		layers = append(layers, item)
+	sizes := layer.Solve(layers, size.Rows, measureRows(size))
 
-		if item.Static() {
+	statics := 0
+	for i := range layers {
+		layers[i] = layer.FromLayer(layers[i], layer.WithValue(sizes[i]))
+		if layers[i].Static() {
 			statics += 1
 		}
 	}
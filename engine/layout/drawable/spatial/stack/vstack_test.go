@@ -419,3 +419,54 @@ func TestVStack_ExactFit_NoExtraNoMissing(t *testing.T) {
 
 	assert.Len(t, 15, lines)
 }
+
+func TestVStack_FlexMin_PadsToSolvedRows(t *testing.T) {
+	mock1 := &drawable_test.MockUnit{
+		Lines: make([]text.Line, 2),
+	}
+	mock2 := &drawable_test.MockUnit{
+		Lines: make([]text.Line, 2),
+	}
+
+	stack := NewVStack().
+		PushLayer(
+			mock1.ToUnit(),
+			layer.Min[winsize.Rows](4),
+			layer.Grow[winsize.Rows](0),
+		).
+		PushLayer(mock2.ToUnit()).
+		ToUnit()
+
+	stack.Drawable.Init()
+
+	lines, _ := stack.Drawable.Draw(winsize.Winsize{Rows: 10, Cols: 10})
+
+	assert.Len(t, 6, lines)
+}
+
+func TestVStack_FlexMin_SharesWithDynamic(t *testing.T) {
+	mock1 := &drawable_test.MockUnit{
+		Lines: []text.Line{*text.NewLine("min")},
+	}
+
+	mock2 := &drawable_test.MockUnit{
+		Lines: make([]text.Line, 10),
+	}
+	for i := range mock2.Lines {
+		mock2.Lines[i] = *text.NewLine("dynamic")
+	}
+
+	stack := NewVStack().
+		PushLayer(mock1.ToUnit(), layer.Min[winsize.Rows](4)).
+		PushLayer(mock2.ToUnit()).
+		ToUnit()
+
+	stack.Drawable.Init()
+
+	lines, _ := stack.Drawable.Draw(winsize.Winsize{Rows: 10, Cols: 10})
+
+	assert.Len(t, 10, lines)
+	assert.Equal(t, "min", text.LineToString(&lines[0]))
+	assert.Equal(t, "", text.LineToString(&lines[4]))
+	assert.Equal(t, "dynamic", text.LineToString(&lines[5]))
+}
@@ -0,0 +1,176 @@
+package zstack
+
+import (
+	"slices"
+	"strings"
+
+	"github.com/Rafael24595/go-reacterm-core/engine/layout/transform/drain"
+	"github.com/Rafael24595/go-reacterm-core/engine/model/hit"
+	"github.com/Rafael24595/go-reacterm-core/engine/model/winsize"
+	"github.com/Rafael24595/go-reacterm-core/engine/render/marker"
+	"github.com/Rafael24595/go-reacterm-core/engine/render/style"
+	"github.com/Rafael24595/go-reacterm-core/engine/render/styler"
+	"github.com/Rafael24595/go-reacterm-core/engine/render/text"
+)
+
+const lastCell = winsize.Cols(^uint16(0))
+
+// Compose draws the layers over base in order, the last one on top.
+func Compose(size winsize.Winsize, base []text.Line, layers ...Layer) []text.Line {
+	frame := base
+	for _, layer := range layers {
+		frame = layer.drawOver(size, frame)
+	}
+	return frame
+}
+
+func (l Layer) drawOver(size winsize.Winsize, frame []text.Line) []text.Line {
+	l.unit.Drawable.Init()
+
+	// A layer taller than the area is cut, the frame never grows past
+	// the rows it was given.
+	lines, _ := drain.UnitLazy(size, l.unit)
+	if len(lines) == 0 {
+		return frame
+	}
+
+	rows := len(lines)
+	width := text.MaxLineMeasure(size.Cols, lines...)
+	top, left := l.origin(size, frame, rows, width)
+
+	result := make([]text.Line, max(len(frame), top+rows))
+	copy(result, frame)
+
+	if l.dim {
+		dim(result)
+	}
+
+	spec := styler.NewDefaultSpec()
+	for i, line := range lines {
+		row := top + i
+
+		under := spec.Materialize(result[row], winsize.New(1, size.Cols))
+		over := spec.Materialize(line, winsize.New(1, width))
+
+		result[row] = splice(under, over, left, width)
+	}
+
+	return result
+}
+
+func (l Layer) origin(size winsize.Winsize, frame []text.Line, rows int, width winsize.Cols) (int, winsize.Cols) {
+	areaTop := 0
+	areaRows := int(size.Rows)
+
+	if first, last, ok := ownerRows(frame, l.owner); ok {
+		areaTop = first
+		areaRows = last - first + 1
+	}
+
+	top := areaTop + (areaRows-rows)/2
+	switch l.vertical {
+	case style.Top:
+		top = areaTop
+	case style.Bottom:
+		top = areaTop + areaRows - rows
+	}
+
+	top = max(0, min(top, int(size.Rows)-rows))
+
+	free := size.Cols.Sub(width)
+
+	left := free / 2
+	switch l.horizontal {
+	case style.Left:
+		left = 0
+	case style.Right:
+		left = free
+	}
+
+	return top, left
+}
+
+func ownerRows(frame []text.Line, owner string) (int, int, bool) {
+	if owner == "" {
+		return 0, 0, false
+	}
+
+	first, last := -1, -1
+	for i, line := range frame {
+		if !ownedBy(line.Hit, owner) {
+			continue
+		}
+
+		if first < 0 {
+			first = i
+		}
+		last = i
+	}
+
+	return first, last, first >= 0
+}
+
+func ownedBy(target *hit.Target, owner string) bool {
+	for ; target != nil; target = target.Inner {
+		if target.Is(owner) {
+			return true
+		}
+	}
+	return false
+}
+
+func dim(lines []text.Line) {
+	for i := range lines {
+		lines[i].Text = slices.Clone(lines[i].Text)
+		for j := range lines[i].Text {
+			lines[i].Text[j].AddAtom(style.AtmDim)
+		}
+	}
+}
+
+// splice replaces the cells [left, left+width) of under with over. Both
+// lines must be materialized so their fragments measure what they print.
+func splice(under, over text.Line, left, width winsize.Cols) text.Line {
+	under.SpreadHit()
+	over.SpreadHit()
+
+	frags := sliceCells(under.Text, 0, left, true)
+
+	covered := winsize.Cols(0)
+	for _, f := range over.Text {
+		f.Paint = f.Paint.Inherit(over.Paint)
+		f.Role = f.Role.Or(over.Role)
+		frags = append(frags, f)
+		covered += f.Size()
+	}
+
+	if covered < width {
+		padding := text.NewFragment(strings.Repeat(marker.DefaultPaddingText, int(width-covered)))
+		padding.Paint = over.Paint
+		padding.Role = over.Role
+		padding.Hit = over.Hit
+		frags = append(frags, *padding)
+	}
+
+	frags = append(frags, sliceCells(under.Text, left+width, lastCell, false)...)
+
+	result := under
+	result.Text = frags
+	result.Hit = over.Hit
+
+	return result
+}
+
+// sliceCells keeps the cells [from, to) of frags, and fill pads a short
+// line up to the last cell.
+func sliceCells(frags []text.Fragment, from, to winsize.Cols, fill bool) []text.Fragment {
+	result := text.SliceCells(frags, from, to)
+
+	measure := text.FragmentMeasure(lastCell, frags...)
+	if fill && measure < to {
+		gap := to - max(measure, from)
+		result = append(result, *text.NewFragment(strings.Repeat(marker.DefaultPaddingText, int(gap))))
+	}
+
+	return result
+}
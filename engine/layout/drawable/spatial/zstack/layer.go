@@ -0,0 +1,46 @@
+package zstack
+
+import (
+	"github.com/Rafael24595/go-reacterm-core/engine/layout/drawable"
+	"github.com/Rafael24595/go-reacterm-core/engine/render/style"
+)
+
+// Layer is a unit drawn over the frame beneath it. It is sized to its
+// content and placed by its position, either in the whole frame or in
+// the rows of the unit it is relative to.
+type Layer struct {
+	unit       drawable.Unit
+	vertical   style.VerticalPosition
+	horizontal style.HorizontalPosition
+	owner      string
+	dim        bool
+}
+
+func NewLayer(unit drawable.Unit) *Layer {
+	return &Layer{
+		unit:       unit,
+		vertical:   style.Middle,
+		horizontal: style.Center,
+		owner:      "",
+		dim:        false,
+	}
+}
+
+func (l *Layer) Position(vertical style.VerticalPosition, horizontal style.HorizontalPosition) *Layer {
+	l.vertical = vertical
+	l.horizontal = horizontal
+	return l
+}
+
+// RelativeTo places the layer within the rows whose hit target belongs
+// to owner, falling back to the whole frame while none is on screen.
+func (l *Layer) RelativeTo(owner string) *Layer {
+	l.owner = owner
+	return l
+}
+
+// Dim fades the content behind the layer.
+func (l *Layer) Dim() *Layer {
+	l.dim = true
+	return l
+}
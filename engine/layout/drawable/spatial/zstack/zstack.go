@@ -0,0 +1,59 @@
+package zstack
+
+import (
+	assert "github.com/Rafael24595/go-assert/assert/runtime"
+
+	"github.com/Rafael24595/go-reacterm-core/engine/layout/drawable"
+	"github.com/Rafael24595/go-reacterm-core/engine/layout/transform/drain"
+	"github.com/Rafael24595/go-reacterm-core/engine/model/winsize"
+	"github.com/Rafael24595/go-reacterm-core/engine/render/text"
+)
+
+const Name = "zstack_unit"
+
+// ZStackUnit draws its base and then each layer over it, so floating
+// content keeps the base in view instead of replacing it.
+type ZStackUnit struct {
+	loaded bool
+	base   drawable.Unit
+	layers []Layer
+}
+
+func New(base drawable.Unit) *ZStackUnit {
+	return &ZStackUnit{
+		loaded: false,
+		base:   base,
+		layers: make([]Layer, 0),
+	}
+}
+
+func (u *ZStackUnit) Push(layers ...*Layer) *ZStackUnit {
+	assert.False(u.loaded, drawable.MessageNewElement)
+
+	for _, layer := range layers {
+		u.layers = append(u.layers, *layer)
+	}
+	return u
+}
+
+func (u *ZStackUnit) ToUnit() drawable.Unit {
+	return drawable.NewBuilder().
+		Name(Name).
+		MergeTags(u.base.Tags).
+		Init(u.init).
+		Wipe(u.base.Drawable.Wipe).
+		Draw(u.draw).
+		ToUnit()
+}
+
+func (u *ZStackUnit) init() {
+	u.loaded = true
+	u.base.Drawable.Init()
+}
+
+func (u *ZStackUnit) draw(size winsize.Winsize) ([]text.Line, bool) {
+	assert.True(u.loaded, drawable.MessageInitialized)
+
+	lines, hasNext := drain.UnitLazy(size, u.base)
+	return Compose(size, lines, u.layers...), hasNext
+}
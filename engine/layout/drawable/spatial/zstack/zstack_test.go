@@ -0,0 +1,179 @@
+package zstack
+
+import (
+	"strings"
+	"testing"
+
+	assert "github.com/Rafael24595/go-assert/assert/test"
+
+	"github.com/Rafael24595/go-reacterm-core/engine/model/hit"
+	"github.com/Rafael24595/go-reacterm-core/engine/model/winsize"
+	"github.com/Rafael24595/go-reacterm-core/engine/render/style"
+	"github.com/Rafael24595/go-reacterm-core/engine/render/text"
+
+	drawable_test "github.com/Rafael24595/go-reacterm-core/test/engine/layout/drawable"
+)
+
+func frame(lines ...string) []text.Line {
+	result := make([]text.Line, len(lines))
+	for i, l := range lines {
+		result[i] = *text.NewLine(l)
+	}
+	return result
+}
+
+func render(lines []text.Line) string {
+	result := make([]string, len(lines))
+	for i := range lines {
+		result[i] = text.LineToString(&lines[i])
+	}
+	return strings.Join(result, "\n")
+}
+
+func layer(lines ...string) *Layer {
+	mock := &drawable_test.MockUnit{
+		Lines: frame(lines...),
+	}
+	return NewLayer(mock.ToUnit())
+}
+
+func TestZStack_UnitBasicSuite(t *testing.T) {
+	mock := &drawable_test.MockUnit{}
+	unit := New(mock.ToUnit()).ToUnit()
+	drawable_test.Test_UnitBasicSuite(t, unit)
+}
+
+func TestZStack_DrawsLayerOverBase(t *testing.T) {
+	base := &drawable_test.MockUnit{
+		Lines: frame("aaaaaa", "bbbbbb", "cccccc"),
+	}
+
+	unit := New(base.ToUnit()).
+		Push(layer("xx")).
+		ToUnit()
+
+	unit.Drawable.Init()
+
+	lines, hasNext := unit.Drawable.Draw(winsize.New(3, 6))
+
+	assert.False(t, hasNext)
+	assert.Equal(t, "aaaaaa\nbbxxbb\ncccccc", render(lines))
+}
+
+func TestCompose_Corners(t *testing.T) {
+	size := winsize.New(3, 6)
+	base := frame("aaaaaa", "bbbbbb", "cccccc")
+
+	top := layer("xx").Position(style.Top, style.Left)
+	bottom := layer("yy").Position(style.Bottom, style.Right)
+
+	lines := Compose(size, base, *top, *bottom)
+
+	assert.Equal(t, "xxaaaa\nbbbbbb\nccccyy", render(lines))
+}
+
+func TestCompose_PadsShortBase(t *testing.T) {
+	size := winsize.New(3, 6)
+	base := frame("ab")
+
+	lines := Compose(size, base, *layer("xx").Position(style.Bottom, style.Right))
+
+	assert.Equal(t, "ab\n\n    xx", render(lines))
+}
+
+func TestCompose_KeepsBaseUntouched(t *testing.T) {
+	size := winsize.New(1, 6)
+	base := frame("aaaaaa")
+
+	Compose(size, base, *layer("xx").Dim())
+
+	assert.Equal(t, "aaaaaa", text.LineToString(&base[0]))
+	assert.True(t, base[0].Text[0].Atom.HasNone(style.AtmDim))
+}
+
+func TestCompose_Dim(t *testing.T) {
+	size := winsize.New(1, 6)
+	base := frame("aaaaaa")
+
+	lines := Compose(size, base, *layer("xx").Dim())
+
+	assert.Equal(t, "aaxxaa", text.LineToString(&lines[0]))
+	for _, f := range lines[0].Text {
+		if f.Text == "xx" {
+			assert.True(t, f.Atom.HasNone(style.AtmDim))
+			continue
+		}
+		assert.True(t, f.Atom.HasAny(style.AtmDim))
+	}
+}
+
+func TestCompose_RelativeTo(t *testing.T) {
+	size := winsize.New(5, 4)
+	base := frame("aaaa", "bbbb", "cccc", "dddd", "eeee")
+	base[3].Hit = hit.New("table", 0)
+	base[4].Hit = hit.Wrap(hit.New("table", 1), "form", 0)
+
+	lines := Compose(size, base, *layer("x").Position(style.Top, style.Left).RelativeTo("table"))
+
+	assert.Equal(t, "aaaa\nbbbb\ncccc\nxddd\neeee", render(lines))
+	assert.Nil(t, lines[3].Hit)
+}
+
+func TestCompose_RelativeToMissingOwner(t *testing.T) {
+	size := winsize.New(3, 4)
+	base := frame("aaaa", "bbbb", "cccc")
+
+	lines := Compose(size, base, *layer("x").Position(style.Top, style.Left).RelativeTo("table"))
+
+	assert.Equal(t, "xaaa\nbbbb\ncccc", render(lines))
+}
+
+func TestSplice_CutsWideClusters(t *testing.T) {
+	under := *text.NewLine("日本語")
+	over := *text.NewLine("x")
+
+	line := splice(under, over, 1, 3)
+
+	assert.Equal(t, " x  語", text.LineToString(&line))
+	assert.Equal(t, 6, int(text.FragmentMeasure(10, line.Text...)))
+}
+
+func TestSliceCells_Fill(t *testing.T) {
+	frags := []text.Fragment{*text.NewFragment("ab")}
+
+	got := sliceCells(frags, 0, 4, true)
+
+	line := text.LineFromFragments(got...)
+	assert.Equal(t, "ab  ", text.LineToString(line))
+}
+
+func TestCompose_CutsTallLayer(t *testing.T) {
+	base := frame("aaaa", "bbbb", "cccc")
+	lines := Compose(winsize.New(3, 4), base, *layer("1", "2", "3", "4", "5"))
+
+	assert.Len(t, 3, lines)
+	assert.Equal(t, "a1aa\nb2bb\nc3cc", render(lines))
+}
+
+func TestZStack_PagesTallBase(t *testing.T) {
+	base := &drawable_test.MockUnit{
+		Lines: frame("aaaa", "bbbb", "cccc", "dddd"),
+		Batch: 1,
+	}
+
+	unit := New(base.ToUnit()).
+		Push(layer("xx")).
+		ToUnit()
+
+	unit.Drawable.Init()
+
+	lines, hasNext := unit.Drawable.Draw(winsize.New(3, 4))
+
+	assert.True(t, hasNext)
+	assert.Equal(t, "aaaa\nbxxb\ncccc", render(lines))
+
+	lines, hasNext = unit.Drawable.Draw(winsize.New(3, 4))
+
+	assert.False(t, hasNext)
+	assert.Equal(t, "dddd\n xx", render(lines))
+}
@@ -17,8 +17,8 @@ func DrawTransformer(opts ...Option) pipeline.DrawTransformer {
 	rightMeasure := runes.Measure(meta.right)
 	measure := leftMeasure + rightMeasure
 
-	leftFrag := text.NewFragment(meta.left)
-	rightFrag := text.NewFragment(meta.right)
+	leftFrag := text.NewFragment(meta.left).SetRole(meta.role)
+	rightFrag := text.NewFragment(meta.right).SetRole(meta.role)
 
 	return func(size winsize.Winsize, unit drawable.Unit) ([]text.Line, bool) {
 		if measure >= size.Cols {
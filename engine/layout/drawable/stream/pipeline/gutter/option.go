@@ -1,9 +1,12 @@
 package gutter
 
-import "github.com/Rafael24595/go-reacterm-core/engine/render/marker"
+import (
+	"github.com/Rafael24595/go-reacterm-core/engine/render/marker"
+	"github.com/Rafael24595/go-reacterm-core/engine/render/style"
+	"github.com/Rafael24595/go-reacterm-core/engine/render/theme"
+)
 
 const (
-	DefaultLeft  = marker.DefaultLeftGutterText + " "
 	DefaultEmpty = marker.DefaultPaddingText + marker.DefaultPaddingText
 )
 
@@ -12,12 +15,14 @@ type Option func(*meta)
 type meta struct {
 	left  string
 	right string
+	role  style.Role
 }
 
 func defaultMeta() meta {
 	return meta{
-		left:  DefaultLeft,
+		left:  theme.Current().Glyph(theme.GlyphGutterLeft) + " ",
 		right: "",
+		role:  style.RoleGutterActive,
 	}
 }
 
@@ -40,6 +45,12 @@ func WithRightGutter(gutter string) Option {
 	}
 }
 
+func WithRole(role style.Role) Option {
+	return func(cfg *meta) {
+		cfg.role = role
+	}
+}
+
 func newMeta(opts ...Option) meta {
 	if len(opts) == 0 {
 		return defaultMeta()
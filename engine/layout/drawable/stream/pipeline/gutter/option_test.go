@@ -4,12 +4,16 @@ import (
 	"testing"
 
 	assert "github.com/Rafael24595/go-assert/assert/test"
+
+	"github.com/Rafael24595/go-reacterm-core/engine/render/marker"
+	"github.com/Rafael24595/go-reacterm-core/engine/render/style"
+	"github.com/Rafael24595/go-reacterm-core/engine/terminal/capability"
 )
 
 func TestDefaultMeta(t *testing.T) {
 	cfg := defaultMeta()
 
-	assert.Equal(t, DefaultLeft, cfg.left)
+	assert.Equal(t, marker.LeftGutterText()+" ", cfg.left)
 	assert.Len(t, 0, cfg.right)
 }
 
@@ -39,3 +43,22 @@ func TestWithRightGutter(t *testing.T) {
 	assert.Len(t, 0, cfg.left)
 	assert.Equal(t, "<", cfg.right)
 }
+
+func TestDefaultMeta_AsciiFallback(t *testing.T) {
+	defer capability.Set(capability.Full())
+
+	capability.Set(capability.Basic())
+	cfg := defaultMeta()
+
+	assert.Equal(t, marker.AsciiLeftGutterText+" ", cfg.left)
+}
+
+func TestWithRole(t *testing.T) {
+	cfg := newMeta(
+		WithLeftGutter(">"),
+		WithRole(style.RoleGutter),
+	)
+
+	assert.Equal(t, style.RoleGutter, cfg.role)
+	assert.Equal(t, style.RoleGutterActive, defaultMeta().role)
+}
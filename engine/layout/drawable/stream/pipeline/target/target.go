@@ -0,0 +1,49 @@
+package target
+
+import (
+	"github.com/Rafael24595/go-reacterm-core/engine/layout/drawable"
+	"github.com/Rafael24595/go-reacterm-core/engine/layout/drawable/stream/pipeline"
+	"github.com/Rafael24595/go-reacterm-core/engine/model/hit"
+	"github.com/Rafael24595/go-reacterm-core/engine/model/winsize"
+	"github.com/Rafael24595/go-reacterm-core/engine/render/text"
+)
+
+const Name = "target_pipeline"
+
+func DataTransformer(owner string, index uint16) pipeline.DataTransformer {
+	return func(_ winsize.Winsize, _ drawable.Unit, lines []text.Line, hasNext bool) ([]text.Line, bool) {
+		targeted := make([]text.Line, len(lines))
+		copy(targeted, lines)
+
+		for i := range targeted {
+			targeted[i].Hit = hit.Wrap(targeted[i].Hit, owner, index)
+			targeted[i].Text = wrapFragments(targeted[i].Text, owner, index)
+		}
+
+		return targeted, hasNext
+	}
+}
+
+// wrapFragments wraps the targets carried by single fragments, leaving
+// the others to resolve through their line.
+func wrapFragments(frags []text.Fragment, owner string, index uint16) []text.Fragment {
+	wrapped := make([]text.Fragment, len(frags))
+	for i, f := range frags {
+		if f.Hit != nil {
+			f.Hit = hit.Wrap(f.Hit, owner, index)
+		}
+		wrapped[i] = f
+	}
+	return wrapped
+}
+
+func Unit(unit drawable.Unit, owner string, index uint16) drawable.Unit {
+	unt := pipeline.New(unit).
+		PushDataSteps(
+			DataTransformer(owner, index),
+		).
+		ToUnit()
+
+	unt.Name = Name
+	return unt
+}
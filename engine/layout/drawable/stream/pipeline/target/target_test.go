@@ -0,0 +1,48 @@
+package target
+
+import (
+	"testing"
+
+	assert "github.com/Rafael24595/go-assert/assert/test"
+
+	"github.com/Rafael24595/go-reacterm-core/engine/model/hit"
+	"github.com/Rafael24595/go-reacterm-core/engine/model/winsize"
+	"github.com/Rafael24595/go-reacterm-core/engine/render/text"
+
+	drawable_test "github.com/Rafael24595/go-reacterm-core/test/engine/layout/drawable"
+)
+
+func TestTarget_WrapsLines(t *testing.T) {
+	mock := &drawable_test.MockUnit{
+		Lines: []text.Line{
+			*text.NewLine("plain"),
+			*text.NewLine("inner").SetHit(hit.New("menu", 3)),
+		},
+	}
+
+	unit := Unit(mock.ToUnit(), "form", 1)
+	unit.Drawable.Init()
+
+	lines, _ := unit.Drawable.Draw(winsize.New(2, 10))
+
+	assert.Len(t, 2, lines)
+
+	assert.True(t, lines[0].Hit.Is("form"))
+	assert.Equal(t, 1, lines[0].Hit.Index)
+	assert.Nil(t, lines[0].Hit.Inner)
+
+	assert.True(t, lines[1].Hit.Is("form"))
+	assert.True(t, lines[1].Hit.Inner.Is("menu"))
+	assert.Equal(t, 3, lines[1].Hit.Inner.Index)
+}
+
+func TestTarget_DoesNotMutateSource(t *testing.T) {
+	source := []text.Line{
+		*text.NewLine("plain"),
+	}
+
+	transformer := DataTransformer("form", 0)
+	transformer(winsize.New(1, 10), (&drawable_test.MockUnit{}).ToUnit(), source, false)
+
+	assert.Nil(t, source[0].Hit)
+}
@@ -6,6 +6,7 @@ import (
 	"github.com/Rafael24595/go-reacterm-core/engine/layout/drawable"
 	"github.com/Rafael24595/go-reacterm-core/engine/layout/drawable/primitive/line"
 	"github.com/Rafael24595/go-reacterm-core/engine/layout/drawable/spatial/justify"
+	"github.com/Rafael24595/go-reacterm-core/engine/model/hit"
 	"github.com/Rafael24595/go-reacterm-core/engine/model/input"
 	"github.com/Rafael24595/go-reacterm-core/engine/model/winsize"
 	"github.com/Rafael24595/go-reacterm-core/engine/render/marker"
@@ -17,6 +18,7 @@ const Name = "check_menu_unit"
 
 type CheckMenuUnit struct {
 	initialized  bool
+	target       string
 	meta         marker.CheckMeta
 	distribution style.Distribution
 	writeMode    bool
@@ -31,6 +33,7 @@ func New(options []input.CheckOption) *CheckMenuUnit {
 
 	return &CheckMenuUnit{
 		initialized:  false,
+		target:       "",
 		meta:         marker.BracketsCheck,
 		distribution: style.DefaultDistribution,
 		options:      clone,
@@ -43,6 +46,11 @@ func UnitFromOptions(options []input.CheckOption) drawable.Unit {
 	return New(options).ToUnit()
 }
 
+func (u *CheckMenuUnit) Target(owner string) *CheckMenuUnit {
+	u.target = owner
+	return u
+}
+
 func (u *CheckMenuUnit) Meta(meta marker.CheckMeta) *CheckMenuUnit {
 	u.meta = meta
 	return u
@@ -102,12 +110,16 @@ func (u *CheckMenuUnit) makeVertical(opts []text.Fragment) drawable.Unit {
 	lines := make([]text.Line, len(opts))
 	for i := range opts {
 		lines[i] = *text.LineFromFragments(opts[i])
+		if u.target != "" {
+			lines[i].SetHit(hit.New(u.target, uint16(i)))
+		}
 	}
 	return line.UnitFromLines(lines...)
 }
 
 func (u *CheckMenuUnit) makeHorizontal(opts []text.Fragment) drawable.Unit {
 	return justify.New(opts).
+		Target(u.target).
 		Justify(u.distribution.Justify).
 		MaxOpts(u.distribution.Limit).
 		ToUnit()
@@ -131,7 +143,8 @@ func (u *CheckMenuUnit) addStyles() []text.Fragment {
 			CopyMeta(&u.options[i].Label)
 
 		if u.writeMode && i == int(u.cursor) {
-			frags[i].AddAtom(style.AtmSelect, style.AtmFocus)
+			frags[i].AddAtom(style.AtmFocus).
+				SetRole(style.RoleFocus)
 		}
 	}
 
@@ -90,8 +90,8 @@ func makeUnit(fields []key.Descriptor) drawable.Unit {
 			*text.NewFragment("--Help--"),
 			*text.NewFragment("-").
 				AddSpec(style.SpecFromKind(style.SpcKindFill)),
-		),
-		*text.LineFromFragments(frags...),
-		*text.NewLine("-", style.SpecFromKind(style.SpcKindFill)),
+		).SetRole(style.RoleHelp),
+		*text.LineFromFragments(frags...).SetRole(style.RoleHelp),
+		*text.NewLine("-", style.SpecFromKind(style.SpcKindFill)).SetRole(style.RoleHelp),
 	)
 }
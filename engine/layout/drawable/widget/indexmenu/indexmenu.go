@@ -7,6 +7,7 @@ import (
 	"github.com/Rafael24595/go-reacterm-core/engine/helper/math"
 	"github.com/Rafael24595/go-reacterm-core/engine/layout/drawable"
 	"github.com/Rafael24595/go-reacterm-core/engine/layout/drawable/stream/pipeline/drain"
+	"github.com/Rafael24595/go-reacterm-core/engine/model/hit"
 	"github.com/Rafael24595/go-reacterm-core/engine/model/winsize"
 	"github.com/Rafael24595/go-reacterm-core/engine/render/marker"
 	"github.com/Rafael24595/go-reacterm-core/engine/render/style"
@@ -17,6 +18,7 @@ const Name = "index_menu_unit"
 
 type IndexMenuUnit struct {
 	loaded  bool
+	target  string
 	pointer Pointer
 	meta    marker.IndexMeta
 	options []text.Fragment
@@ -30,6 +32,7 @@ func New(options []text.Fragment) *IndexMenuUnit {
 
 	return &IndexMenuUnit{
 		loaded:  false,
+		target:  "",
 		pointer: pointerSelect,
 		meta:    marker.HyphenIndex,
 		options: clone,
@@ -47,6 +50,11 @@ func (u *IndexMenuUnit) Pointer(pointer Pointer) *IndexMenuUnit {
 	return u
 }
 
+func (u *IndexMenuUnit) Target(owner string) *IndexMenuUnit {
+	u.target = owner
+	return u
+}
+
 func (u *IndexMenuUnit) Meta(meta marker.IndexMeta) *IndexMenuUnit {
 	u.meta = meta
 	return u
@@ -75,34 +83,41 @@ func (u *IndexMenuUnit) init() {
 
 	for i, o := range u.options {
 		focusAtom := style.AtmNone
-		selectAtom := style.AtmNone
+		selectRole := style.RoleNone
 		if i == int(u.cursor) {
 			focusAtom = style.AtmFocus
 			if u.pointer == pointerSelect {
-				selectAtom = style.AtmSelect
+				selectRole = style.RoleFocus
 			}
 		}
 
 		paddingFrag := text.EmptyFragment().
 			AddSpec(style.SpecPaddingLeft(2))
 
-		indexFrag := u.makeIndex(i, winsize.Cols(digits)).
-			AddAtom(selectAtom)
+		indexFrag := u.makeIndex(i, winsize.Cols(digits))
+		if !selectRole.IsNone() {
+			indexFrag.SetRole(selectRole)
+		}
 
 		spacerFrag := text.NewFragment(marker.DefaultPaddingText).
-			AddAtom(selectAtom)
+			SetRole(selectRole)
 
 		titleFrag := text.NewFragment(o.Text).
-			AddAtom(focusAtom, selectAtom)
-
-		lines = append(lines,
-			*text.LineFromFragments(
-				*paddingFrag,
-				*indexFrag,
-				*spacerFrag,
-				*titleFrag,
-			),
+			AddAtom(focusAtom).
+			SetRole(selectRole)
+
+		line := text.LineFromFragments(
+			*paddingFrag,
+			*indexFrag,
+			*spacerFrag,
+			*titleFrag,
 		)
+
+		if u.target != "" {
+			line.SetHit(hit.New(u.target, uint16(i)))
+		}
+
+		lines = append(lines, *line)
 	}
 
 	unit := drain.UnitFromLines(lines...)
@@ -144,7 +159,7 @@ func (u *IndexMenuUnit) makeAlphabeticIndex(cursor int, digits winsize.Cols) *te
 func (u *IndexMenuUnit) makeCommonIndex(cursor int, txt string) *text.Fragment {
 	index := text.NewFragment(txt + ".- ")
 	if u.pointer == pointerBold && cursor == int(u.cursor) {
-		index.Atom |= style.AtmBold
+		index.SetRole(style.RoleEmphasis)
 	}
 	return index
 }
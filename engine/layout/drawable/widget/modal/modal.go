@@ -26,6 +26,7 @@ type ModalUnit struct {
 	options    []text.Fragment
 	limit      uint
 	cursor     uint16
+	floating   bool
 	unit       drawable.Unit
 }
 
@@ -37,6 +38,7 @@ func New() *ModalUnit {
 		options:    make([]text.Fragment, 0),
 		limit:      style.DefaultMaxOpts,
 		cursor:     0,
+		floating:   false,
 		unit:       drawable.Unit{},
 	}
 }
@@ -61,6 +63,13 @@ func (u *ModalUnit) DefineCursor(cursor uint16) *ModalUnit {
 	return u
 }
 
+// Floating draws only the box, leaving its placement to an overlay
+// layer instead of centring it in the whole area.
+func (u *ModalUnit) Floating() *ModalUnit {
+	u.floating = true
+	return u
+}
+
 func (u *ModalUnit) ToUnit() drawable.Unit {
 	return drawable.NewBuilder().
 		Name(Name).
@@ -90,7 +99,7 @@ func (u *ModalUnit) lazyInit(size winsize.Winsize) {
 			AddSpec(old.Spec)
 
 		if i == int(u.cursor) {
-			opts[i].AddAtom(style.AtmSelect)
+			opts[i].SetRole(style.RoleFocus)
 		}
 	}
 
@@ -118,6 +127,12 @@ func (u *ModalUnit) lazyInit(size winsize.Winsize) {
 		PaddingY(1).
 		ToUnit()
 
+	if u.floating {
+		box.Drawable.Init()
+		u.unit = box
+		return
+	}
+
 	position := padding.NewBuilder().
 		Y(hint.Maximize[winsize.Rows](), rows.WithPosition(style.Middle)).
 		X(hint.Maximize[winsize.Cols](), cols.WithPosition(style.Center)).
@@ -0,0 +1,71 @@
+package split
+
+import (
+	"strings"
+
+	assert "github.com/Rafael24595/go-assert/assert/runtime"
+
+	"github.com/Rafael24595/go-reacterm-core/engine/layout/drawable"
+	"github.com/Rafael24595/go-reacterm-core/engine/model/winsize"
+	"github.com/Rafael24595/go-reacterm-core/engine/render/style"
+	"github.com/Rafael24595/go-reacterm-core/engine/render/text"
+	"github.com/Rafael24595/go-reacterm-core/engine/render/theme"
+)
+
+const NameDivider = "split_divider_unit"
+
+// DividerUnit draws the line between two panes: a column as tall as
+// its box when the panes sit side by side, a row otherwise.
+type DividerUnit struct {
+	loaded   bool
+	vertical bool
+	role     style.Role
+}
+
+func NewDivider(vertical bool) *DividerUnit {
+	return &DividerUnit{
+		loaded:   false,
+		vertical: vertical,
+		role:     style.RoleBorder,
+	}
+}
+
+func (u *DividerUnit) SetRole(role style.Role) *DividerUnit {
+	u.role = role
+	return u
+}
+
+func (u *DividerUnit) ToUnit() drawable.Unit {
+	return drawable.NewBuilder().
+		Name(NameDivider).
+		Init(u.init).
+		Wipe(u.wipe).
+		Draw(u.draw).
+		ToUnit()
+}
+
+func (u *DividerUnit) init() {
+	u.loaded = true
+}
+
+func (u *DividerUnit) wipe() {}
+
+func (u *DividerUnit) draw(size winsize.Winsize) ([]text.Line, bool) {
+	assert.True(u.loaded, drawable.MessageInitialized)
+
+	if u.vertical {
+		glyph := theme.Current().Glyph(theme.GlyphDividerHorizontal)
+		line := text.NewLine(strings.Repeat(glyph, int(size.Cols))).
+			SetRole(u.role)
+		return []text.Line{*line}, false
+	}
+
+	glyph := theme.Current().Glyph(theme.GlyphDividerVertical)
+
+	lines := make([]text.Line, size.Rows)
+	for i := range lines {
+		lines[i] = *text.NewLine(glyph).SetRole(u.role)
+	}
+
+	return lines, false
+}
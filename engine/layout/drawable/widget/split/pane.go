@@ -0,0 +1,146 @@
+package split
+
+import (
+	"strings"
+
+	assert "github.com/Rafael24595/go-assert/assert/runtime"
+
+	"github.com/Rafael24595/go-reacterm-core/engine/commons/structure/set"
+	"github.com/Rafael24595/go-reacterm-core/engine/layout/drawable"
+	"github.com/Rafael24595/go-reacterm-core/engine/layout/transform/drain"
+	"github.com/Rafael24595/go-reacterm-core/engine/model/winsize"
+	"github.com/Rafael24595/go-reacterm-core/engine/render/marker"
+	"github.com/Rafael24595/go-reacterm-core/engine/render/sink"
+	"github.com/Rafael24595/go-reacterm-core/engine/render/text"
+	"github.com/Rafael24595/go-reacterm-core/engine/render/wrap"
+)
+
+const NamePane = "split_pane_unit"
+
+// PaneUnit fills its whole box with the content of a unit, cutting what
+// does not fit and padding the rest, so the dividers around it stay put.
+// The header and footer keep their rows and the content takes what they
+// leave. Panes do not page: content that may overflow should be wrapped
+// in a viewport to scroll within the pane.
+type PaneUnit struct {
+	loaded bool
+	header drawable.Unit
+	unit   drawable.Unit
+	footer drawable.Unit
+}
+
+func NewPane(unit drawable.Unit) *PaneUnit {
+	return &PaneUnit{
+		loaded: false,
+		header: drawable.Unit{},
+		unit:   unit,
+		footer: drawable.Unit{},
+	}
+}
+
+func (u *PaneUnit) Header(unit drawable.Unit) *PaneUnit {
+	assert.False(u.loaded, drawable.MessageNewElement)
+
+	u.header = unit
+	return u
+}
+
+func (u *PaneUnit) Footer(unit drawable.Unit) *PaneUnit {
+	assert.False(u.loaded, drawable.MessageNewElement)
+
+	u.footer = unit
+	return u
+}
+
+func (u *PaneUnit) ToUnit() drawable.Unit {
+	tags := set.NewSet[string]()
+	for _, unit := range u.units() {
+		tags.Merge(unit.Tags)
+	}
+
+	return drawable.NewBuilder().
+		Name(NamePane).
+		MergeTags(tags).
+		Init(u.init).
+		Wipe(u.wipe).
+		Draw(u.draw).
+		ToUnit()
+}
+
+func (u *PaneUnit) units() []drawable.Unit {
+	units := make([]drawable.Unit, 0, 3)
+	for _, unit := range []drawable.Unit{u.header, u.unit, u.footer} {
+		if !drawable.IsZeroDrawable(unit.Drawable) {
+			units = append(units, unit)
+		}
+	}
+	return units
+}
+
+func (u *PaneUnit) init() {
+	u.loaded = true
+	for _, unit := range u.units() {
+		unit.Drawable.Init()
+	}
+}
+
+func (u *PaneUnit) wipe() {
+	for _, unit := range u.units() {
+		unit.Drawable.Wipe()
+	}
+}
+
+func (u *PaneUnit) draw(size winsize.Winsize) ([]text.Line, bool) {
+	assert.True(u.loaded, drawable.MessageInitialized)
+
+	header := fillRows(size, u.header)
+
+	rest := winsize.New(size.Rows.Sub(winsize.Rows(len(header))), size.Cols)
+	footer := fillRows(rest, u.footer)
+
+	body := winsize.New(rest.Rows.Sub(winsize.Rows(len(footer))), size.Cols)
+	content := fillRows(body, u.unit)
+
+	for winsize.Rows(len(content)) < body.Rows {
+		content = append(content, *text.EmptyLine())
+	}
+
+	lines := make([]text.Line, 0, size.Rows)
+	lines = append(lines, header...)
+	lines = append(lines, content...)
+	lines = append(lines, footer...)
+
+	for i := range lines {
+		measure := text.FragmentMeasure(size.Cols, lines[i].Text...)
+		if measure < size.Cols {
+			lines[i].PushFragments(*text.NewFragment(
+				strings.Repeat(marker.DefaultPaddingText, int(size.Cols-measure)),
+			))
+		}
+	}
+
+	return lines, false
+}
+
+// fillRows draws the unit from its start, wrapped to the width and cut
+// to the rows of the size.
+func fillRows(size winsize.Winsize, unit drawable.Unit) []text.Line {
+	if drawable.IsZeroDrawable(unit.Drawable) || size.Rows == 0 {
+		return make([]text.Line, 0)
+	}
+
+	unit.Drawable.Wipe()
+	content := drain.UnitEager(size, unit)
+
+	lines := make([]text.Line, 0, size.Rows)
+	for _, line := range content {
+		for _, wrapped := range wrap.Line(size.Cols, &line) {
+			if winsize.Rows(len(lines)) >= size.Rows {
+				return lines
+			}
+			lines = append(lines, *sink.ApplySinks(&wrapped, size.Cols))
+		}
+	}
+
+	return lines
+}
@@ -0,0 +1,71 @@
+package split
+
+const (
+	DefaultStep     = uint8(5)
+	DefaultMinRatio = uint8(10)
+)
+
+// EvenRatios shares a hundred percent between size panes, the first
+// ones taking the remainder.
+func EvenRatios(size int) []uint8 {
+	ratios := make([]uint8, size)
+	if size == 0 {
+		return ratios
+	}
+
+	share := 100 / size
+	rest := 100 % size
+	for i := range ratios {
+		ratios[i] = uint8(share)
+		if i < rest {
+			ratios[i] += 1
+		}
+	}
+
+	return ratios
+}
+
+// ValidRatios reports whether ratios split a hundred percent between
+// size panes.
+func ValidRatios(ratios []uint8, size int) bool {
+	if len(ratios) != size {
+		return false
+	}
+
+	total := 0
+	for _, r := range ratios {
+		total += int(r)
+	}
+
+	return total == 100
+}
+
+// Resize moves step percent between the pane at index and its next
+// neighbour, or the previous one for the last pane, never leaving any
+// of them under low. It reports whether the ratios changed.
+func Resize(ratios []uint8, index int, grow bool, step, low uint8) bool {
+	if len(ratios) < 2 || index < 0 || index >= len(ratios) {
+		return false
+	}
+
+	other := index + 1
+	if other == len(ratios) {
+		other = index - 1
+	}
+
+	from, to := other, index
+	if !grow {
+		from, to = index, other
+	}
+
+	if ratios[from] <= low {
+		return false
+	}
+
+	step = min(step, ratios[from]-low)
+
+	ratios[from] -= step
+	ratios[to] += step
+
+	return true
+}
@@ -0,0 +1,150 @@
+package split
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	assert "github.com/Rafael24595/go-assert/assert/test"
+
+	"github.com/Rafael24595/go-reacterm-core/engine/config/layer"
+	"github.com/Rafael24595/go-reacterm-core/engine/layout/drawable"
+	"github.com/Rafael24595/go-reacterm-core/engine/layout/drawable/primitive/line"
+	"github.com/Rafael24595/go-reacterm-core/engine/layout/drawable/spatial/stack"
+	"github.com/Rafael24595/go-reacterm-core/engine/model/winsize"
+	"github.com/Rafael24595/go-reacterm-core/engine/render/marker"
+	"github.com/Rafael24595/go-reacterm-core/engine/render/text"
+
+	drawable_test "github.com/Rafael24595/go-reacterm-core/test/engine/layout/drawable"
+)
+
+func list(prefix string, count int) drawable.Unit {
+	lines := make([]text.Line, count)
+	for i := range lines {
+		lines[i] = *text.NewLine(fmt.Sprintf("%s%d", prefix, i))
+	}
+	return line.UnitFromLines(lines...)
+}
+
+func render(lines []text.Line) string {
+	result := make([]string, len(lines))
+	for i := range lines {
+		result[i] = text.LineToString(&lines[i])
+	}
+	return strings.Join(result, "\n")
+}
+
+func TestPane_UnitBasicSuite(t *testing.T) {
+	mock := &drawable_test.MockUnit{}
+	unit := NewPane(mock.ToUnit()).ToUnit()
+	drawable_test.Test_UnitBasicSuite(t, unit)
+}
+
+func TestDivider_UnitBasicSuite(t *testing.T) {
+	unit := NewDivider(false).ToUnit()
+	drawable_test.Test_UnitBasicSuite(t, unit)
+}
+
+func TestPane_FillsBox(t *testing.T) {
+	unit := NewPane(list("a", 2)).ToUnit()
+	unit.Drawable.Init()
+
+	lines, hasNext := unit.Drawable.Draw(winsize.New(3, 4))
+
+	assert.False(t, hasNext)
+	assert.Equal(t, "a0  \na1  \n    ", render(lines))
+}
+
+func TestPane_CutsOverflow(t *testing.T) {
+	unit := NewPane(list("a", 5)).ToUnit()
+	unit.Drawable.Init()
+
+	lines, _ := unit.Drawable.Draw(winsize.New(2, 2))
+	assert.Equal(t, "a0\na1", render(lines))
+
+	lines, _ = unit.Drawable.Draw(winsize.New(2, 2))
+	assert.Equal(t, "a0\na1", render(lines))
+}
+
+func TestPane_KeepsHeaderAndFooter(t *testing.T) {
+	unit := NewPane(list("a", 5)).
+		Header(list("h", 1)).
+		Footer(list("f", 1)).
+		ToUnit()
+	unit.Drawable.Init()
+
+	lines, _ := unit.Drawable.Draw(winsize.New(4, 3))
+	assert.Equal(t, "h0 \na0 \na1 \nf0 ", render(lines))
+
+	lines, _ = unit.Drawable.Draw(winsize.New(1, 3))
+	assert.Equal(t, "h0 ", render(lines))
+}
+
+func TestDivider_Orientation(t *testing.T) {
+	column := NewDivider(false).ToUnit()
+	column.Drawable.Init()
+
+	lines, _ := column.Drawable.Draw(winsize.New(3, 1))
+	glyph := marker.DividerVerticalText()
+	assert.Equal(t, strings.Join([]string{glyph, glyph, glyph}, "\n"), render(lines))
+
+	row := NewDivider(true).ToUnit()
+	row.Drawable.Init()
+
+	lines, _ = row.Drawable.Draw(winsize.New(3, 4))
+	assert.Equal(t, strings.Repeat(marker.DividerHorizontalText(), 4), render(lines))
+}
+
+func TestPane_KeepsDividerInPlace(t *testing.T) {
+	hstack := stack.NewHStack().
+		PushLayer(NewPane(list("a", 1)).ToUnit(), layer.Fixed[winsize.Cols](3)).
+		PushLayer(NewDivider(false).ToUnit(), layer.Fixed[winsize.Cols](1)).
+		PushLayer(NewPane(list("b", 2)).ToUnit())
+
+	unit := hstack.ToUnit()
+	unit.Drawable.Init()
+
+	lines, _ := unit.Drawable.Draw(winsize.New(2, 6))
+
+	glyph := marker.DividerVerticalText()
+	assert.Equal(t, "a0 "+glyph+"b0\n   "+glyph+"b1", render(lines))
+}
+
+func TestEvenRatios(t *testing.T) {
+	assert.Equal(t, "[34 33 33]", fmt.Sprint(EvenRatios(3)))
+	assert.Equal(t, "[50 50]", fmt.Sprint(EvenRatios(2)))
+	assert.Len(t, 0, EvenRatios(0))
+}
+
+func TestValidRatios(t *testing.T) {
+	assert.True(t, ValidRatios([]uint8{30, 70}, 2))
+	assert.False(t, ValidRatios([]uint8{30, 60}, 2))
+	assert.False(t, ValidRatios([]uint8{100}, 2))
+}
+
+func TestResize_TakesFromNextNeighbour(t *testing.T) {
+	ratios := []uint8{40, 30, 30}
+
+	assert.True(t, Resize(ratios, 0, true, 5, 10))
+	assert.Equal(t, "[45 25 30]", fmt.Sprint(ratios))
+
+	assert.True(t, Resize(ratios, 1, false, 5, 10))
+	assert.Equal(t, "[45 20 35]", fmt.Sprint(ratios))
+}
+
+func TestResize_LastPaneUsesPrevious(t *testing.T) {
+	ratios := []uint8{50, 50}
+
+	assert.True(t, Resize(ratios, 1, true, 5, 10))
+	assert.Equal(t, "[45 55]", fmt.Sprint(ratios))
+}
+
+func TestResize_KeepsMinimum(t *testing.T) {
+	ratios := []uint8{88, 12}
+
+	assert.True(t, Resize(ratios, 0, true, 5, 10))
+	assert.Equal(t, "[90 10]", fmt.Sprint(ratios))
+
+	assert.False(t, Resize(ratios, 0, true, 5, 10))
+	assert.False(t, Resize([]uint8{100}, 0, true, 5, 10))
+}
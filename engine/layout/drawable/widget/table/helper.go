@@ -5,6 +5,7 @@ import (
 	"github.com/Rafael24595/go-reacterm-core/engine/helper/runes"
 	"github.com/Rafael24595/go-reacterm-core/engine/layout/drawable"
 	"github.com/Rafael24595/go-reacterm-core/engine/layout/drawable/stream/pipeline/isolated"
+	"github.com/Rafael24595/go-reacterm-core/engine/model/hit"
 	"github.com/Rafael24595/go-reacterm-core/engine/model/input"
 	"github.com/Rafael24595/go-reacterm-core/engine/model/table"
 	"github.com/Rafael24595/go-reacterm-core/engine/model/winsize"
@@ -29,13 +30,14 @@ type col struct {
 	size winsize.Cols
 }
 
-func makeSections(t table.Table, cursor input.MatrixCursor, size winsize.Winsize) []section {
+func makeSections(t table.Table, cursor input.MatrixCursor, target string, size winsize.Winsize) []section {
 	sections := make([]section, 0)
 
 	cols := size.Cols
 	separator := t.GetSeparator()
 	headers := t.GetHeaders()
 	columns := t.GetColumns()
+	links := t.GetLinks()
 
 	baseSize := t.Size()
 	rendSize := renderedRowSize(baseSize, separator)
@@ -62,12 +64,18 @@ func makeSections(t table.Table, cursor input.MatrixCursor, size winsize.Winsize
 			*text.NewFragment(separator.Bottom).AddSpec(specCover),
 		)
 
-		rows := makeTable(table, headers, columns, separator, fixCursor)
+		rows := makeTable(table, headers, columns, links, separator, fixCursor)
 
 		if len(rows) == 0 {
 			continue
 		}
 
+		if target != "" {
+			for y := range rows {
+				rows[y].SetHit(hit.New(target, uint16(y)))
+			}
+		}
+
 		headerRow := makeHeaders(table, headers, separator)
 
 		sections = append(sections, section{
@@ -147,6 +155,7 @@ func makeTable(
 	size map[string]winsize.Cols,
 	headers []string,
 	cols map[string][]string,
+	links map[string][]string,
 	separator marker.TableSeparatorMeta,
 	cursor *input.MatrixCursor,
 ) []text.Line {
@@ -166,7 +175,7 @@ func makeTable(
 		fragments = append(fragments, lSep)
 
 		for x, h := range headers {
-			frag := makeCell(size, cols, cursor, h, y, uint16(x))
+			frag := makeCell(size, cols, links, cursor, h, y, uint16(x))
 			fragments = append(fragments, *frag)
 
 			if x < headersLen-1 {
@@ -191,6 +200,7 @@ func makeTable(
 func makeCell(
 	size map[string]winsize.Cols,
 	cols map[string][]string,
+	links map[string][]string,
 	cursor *input.MatrixCursor,
 	header string,
 	y uint16,
@@ -198,12 +208,15 @@ func makeCell(
 ) *text.Fragment {
 	width := size[header]
 	col := cols[header]
+	link := links[header]
 
 	atom := style.AtmWrap
+	role := style.RoleNone
 
 	cursorShow := cursor != nil && cursor.Show
 	if cursorShow && y == cursor.Row && x == cursor.Col {
-		atom = style.MergeAtom(atom, style.AtmSelect, style.AtmFocus)
+		atom = style.MergeAtom(atom, style.AtmFocus)
+		role = style.RoleFocus
 	}
 
 	if y < uint16(len(col)) {
@@ -212,16 +225,24 @@ func makeCell(
 			style.SpecTrimTextRight(width, marker.DefaultElipsisText),
 		)
 
-		return text.NewFragment(col[y]).
+		frag := text.NewFragment(col[y]).
 			AddSpec(spec).
-			AddAtom(atom)
+			AddAtom(atom).
+			SetRole(role)
+
+		if y < uint16(len(link)) {
+			frag.SetLink(link[y])
+		}
+
+		return frag
 	}
 
 	spec := style.SpecRepeatRight(width)
 
 	return text.NewFragment("").
 		AddSpec(spec).
-		AddAtom(atom)
+		AddAtom(atom).
+		SetRole(role)
 }
 
 func renderedRowSize(size map[string]winsize.Cols, separator marker.TableSeparatorMeta) winsize.Cols {
@@ -101,7 +101,7 @@ func TestMakeTable_Basic(t *testing.T) {
 		"name": {"golang", "ziglang"},
 	}
 
-	lines := makeTable(size, headers, cols, separator, &input.MatrixCursor{})
+	lines := makeTable(size, headers, cols, nil, separator, &input.MatrixCursor{})
 
 	assert.Len(t, 2, lines)
 
@@ -109,6 +109,36 @@ func TestMakeTable_Basic(t *testing.T) {
 	assert.Equal(t, "|2|ziglang|", text.LineToString(&lines[1]))
 }
 
+func TestMakeTable_Links(t *testing.T) {
+	separator := marker.TableSeparatorMeta{
+		Left:   "|",
+		Center: "|",
+		Right:  "|",
+	}
+
+	size := map[string]winsize.Cols{
+		"id":   1,
+		"name": 7,
+	}
+
+	headers := []string{"id", "name"}
+
+	cols := map[string][]string{
+		"id":   {"1", "2"},
+		"name": {"golang", "ziglang"},
+	}
+
+	links := map[string][]string{
+		"name": {"https://go.dev"},
+	}
+
+	lines := makeTable(size, headers, cols, links, separator, &input.MatrixCursor{})
+
+	assert.Equal(t, "https://go.dev", lines[0].Text[3].Link)
+	assert.Equal(t, "", lines[1].Text[3].Link)
+	assert.Equal(t, "", lines[0].Text[1].Link)
+}
+
 func TestAdjustSize_NoReductionNeeded(t *testing.T) {
 	size := map[string]winsize.Cols{
 		"A": 5,
@@ -17,6 +17,7 @@ type TableUnit struct {
 	lazyLoaded bool
 	size       winsize.Winsize
 	table      table.Table
+	target     string
 	sections   []section
 	cursor     input.MatrixCursor
 }
@@ -27,6 +28,7 @@ func New(table table.Table, cursor input.MatrixCursor) *TableUnit {
 		lazyLoaded: false,
 		size:       winsize.Winsize{},
 		table:      table,
+		target:     "",
 		sections:   make([]section, 0),
 		cursor:     cursor,
 	}
@@ -36,6 +38,11 @@ func UnitFromTable(table table.Table, cursor input.MatrixCursor) drawable.Unit {
 	return New(table, cursor).ToUnit()
 }
 
+func (u *TableUnit) Target(owner string) *TableUnit {
+	u.target = owner
+	return u
+}
+
 func (u *TableUnit) ToUnit() drawable.Unit {
 	return drawable.NewBuilder().
 		Name(Name).
@@ -62,7 +69,7 @@ func (u *TableUnit) lazyInit(size winsize.Winsize) {
 	u.lazyLoaded = true
 
 	u.size = size
-	u.sections = makeSections(u.table, u.cursor, size)
+	u.sections = makeSections(u.table, u.cursor, u.target, size)
 
 	for i := range u.sections {
 		u.sections[i].header.Drawable.Init()
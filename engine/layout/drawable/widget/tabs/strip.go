@@ -0,0 +1,42 @@
+package tabs
+
+import (
+	"github.com/Rafael24595/go-reacterm-core/engine/layout/drawable"
+	"github.com/Rafael24595/go-reacterm-core/engine/layout/drawable/primitive/line"
+	"github.com/Rafael24595/go-reacterm-core/engine/render/marker"
+	"github.com/Rafael24595/go-reacterm-core/engine/render/style"
+	"github.com/Rafael24595/go-reacterm-core/engine/render/text"
+	"github.com/Rafael24595/go-reacterm-core/engine/render/theme"
+)
+
+// StripLine lays the labels out on a single line, split by dividers,
+// with the active one focused and the rest muted.
+func StripLine(labels []string, active int) text.Line {
+	divider := theme.Current().Glyph(theme.GlyphDividerVertical)
+
+	frags := make([]text.Fragment, 0, len(labels)*2)
+	for i, label := range labels {
+		if i > 0 {
+			frags = append(frags, *text.NewFragment(divider).
+				SetRole(style.RoleBorder),
+			)
+		}
+
+		role := style.RoleMuted
+		if i == active {
+			role = style.RoleFocus
+		}
+
+		frags = append(frags, *text.NewFragment(
+			marker.DefaultPaddingText+label+marker.DefaultPaddingText,
+		).SetRole(role))
+	}
+
+	return *text.EmptyLine().PushFragments(frags...)
+}
+
+func Strip(labels []string, active int) drawable.Unit {
+	return line.UnitFromLines(
+		StripLine(labels, active),
+	)
+}
@@ -0,0 +1,28 @@
+package tabs
+
+import (
+	"testing"
+
+	assert "github.com/Rafael24595/go-assert/assert/test"
+
+	"github.com/Rafael24595/go-reacterm-core/engine/render/marker"
+	"github.com/Rafael24595/go-reacterm-core/engine/render/style"
+	"github.com/Rafael24595/go-reacterm-core/engine/render/text"
+)
+
+func TestStripLine(t *testing.T) {
+	line := StripLine([]string{"logs", "editor"}, 1)
+
+	divider := marker.DividerVerticalText()
+	assert.Equal(t, " logs "+divider+" editor ", text.LineToString(&line))
+
+	assert.Len(t, 3, line.Text)
+	assert.Equal(t, style.RoleMuted, line.Text[0].Role)
+	assert.Equal(t, style.RoleBorder, line.Text[1].Role)
+	assert.Equal(t, style.RoleFocus, line.Text[2].Role)
+}
+
+func TestStripLine_Empty(t *testing.T) {
+	line := StripLine([]string{}, 0)
+	assert.Len(t, 0, line.Text)
+}
@@ -3,6 +3,7 @@ package selection
 import (
 	assert "github.com/Rafael24595/go-assert/assert/runtime"
 
+	"github.com/Rafael24595/go-reacterm-core/engine/helper/runes"
 	"github.com/Rafael24595/go-reacterm-core/engine/model/ascii"
 	"github.com/Rafael24595/go-reacterm-core/engine/model/input"
 	"github.com/Rafael24595/go-reacterm-core/engine/model/offset"
@@ -20,19 +21,21 @@ type Renderer struct {
 	buffer []rune
 	start  offset.Offset
 	end    offset.Offset
-	blink  style.Atom
+	role   style.Role
 }
 
+// NewRenderer draws the selection between start and end with role, the
+// one the caret returns for its current blink.
 func NewRenderer(
 	buffer []rune,
 	start, end offset.Offset,
-	blink ...style.Atom,
+	role style.Role,
 ) Renderer {
 	return Renderer{
 		buffer: buffer,
 		start:  start,
 		end:    end,
-		blink:  style.MergeAtom(blink...),
+		role:   role,
 	}
 }
 
@@ -62,12 +65,12 @@ func (r Renderer) resolveBackward() Result {
 		focusAtom = style.AtmNone
 
 		frags = append(frags,
-			frag(marker.PrintableCaretRunes, r.blink, style.AtmFocus),
+			frag(marker.PrintableCaretRunes, r.role, style.AtmFocus),
 		)
 	}
 
 	frags = append(frags,
-		frag(selection, r.blink, focusAtom),
+		frag(selection, r.role, focusAtom),
 	)
 
 	return Result{
@@ -89,14 +92,15 @@ func (r Renderer) resolveForwardNonEnter() Result {
 	frags := make([]text.Fragment, 0, 3)
 
 	selection := r.selection()
-	if len(selection) > 1 {
+	last := runes.PrevGrapheme(selection, len(selection))
+	if last > 0 {
 		frags = append(frags,
-			frag(selection[:len(selection)-1], r.blink),
+			frag(selection[:last], r.role),
 		)
 	}
 
 	frags = append(frags,
-		frag(selection[len(selection)-1:], r.blink, style.AtmFocus),
+		frag(selection[last:], r.role, style.AtmFocus),
 	)
 
 	return Result{
@@ -111,15 +115,15 @@ func (r Renderer) resolveForwardEnter() Result {
 	selection := r.selection()
 	if len(selection) == 1 {
 		frags = append(frags,
-			frag(marker.PrintableCaretRunes, r.blink),
+			frag(marker.PrintableCaretRunes, r.role),
 		)
 	}
 
 	footer, nextEnd := r.resolveEnterFooter()
 
 	frags = append(frags,
-		frag(selection, r.blink),
-		frag(footer, r.blink, style.AtmFocus),
+		frag(selection, r.role),
+		frag(footer, r.role, style.AtmFocus),
 	)
 
 	return Result{
@@ -137,7 +141,8 @@ func (r Renderer) resolveEnterFooter() ([]rune, offset.Offset) {
 		return marker.PrintableCaretRunes, r.end
 	}
 
-	return r.buffer[r.end : r.end+1], r.end + 1
+	next := runes.NextGrapheme(r.buffer, r.end)
+	return r.buffer[r.end:next], next
 }
 
 func (r Renderer) resolveEmpty() Result {
@@ -153,7 +158,8 @@ func (r Renderer) resolveEmpty() Result {
 	}
 }
 
-func frag(runes []rune, atoms ...style.Atom) text.Fragment {
+func frag(runes []rune, role style.Role, atoms ...style.Atom) text.Fragment {
 	return *text.FragmentFromRunes(runes).
+		SetRole(role).
 		AddAtom(atoms...)
 }
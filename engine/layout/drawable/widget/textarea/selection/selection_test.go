@@ -155,6 +155,7 @@ func TestRendererResolve(t *testing.T) {
 				buffer,
 				tt.start,
 				tt.end,
+				style.RoleSelection,
 			)
 
 			caret := input.NewTextCursor(false)
@@ -177,6 +178,7 @@ func TestRendererResolve(t *testing.T) {
 
 				assert.Equal(t, escapeLF(expected.content), escapeLF(frag.Text))
 				assert.Equal(t, expected.atoms, frag.Atom)
+				assert.Equal(t, style.RoleSelection, frag.Role)
 			}
 		})
 	}
@@ -3,6 +3,7 @@ package textarea
 import (
 	assert "github.com/Rafael24595/go-assert/assert/runtime"
 
+	"github.com/Rafael24595/go-reacterm-core/engine/helper/runes"
 	"github.com/Rafael24595/go-reacterm-core/engine/layout/drawable"
 	"github.com/Rafael24595/go-reacterm-core/engine/layout/drawable/primitive/line"
 	"github.com/Rafael24595/go-reacterm-core/engine/layout/drawable/widget/textarea/selection"
@@ -80,7 +81,7 @@ func (u *TextAreaUnit) lazyInit(size winsize.Winsize) {
 
 	u.lazyLoaded = true
 
-	start := u.caret.SelectStart().Sub(1)
+	start := runes.PrevGrapheme(u.buffer, u.caret.SelectStart())
 	end := u.caret.SelectEnd()
 
 	if len(u.buffer) == 0 {
@@ -153,9 +154,9 @@ func (u *TextAreaUnit) resolveFragments(
 	return frags
 }
 
-func (u *TextAreaUnit) blinkStyle() style.Atom {
+func (u *TextAreaUnit) blinkStyle() style.Role {
 	if !u.writeMode {
-		return style.AtmNone
+		return style.RoleNone
 	}
 
 	return u.caret.BlinkStyle()
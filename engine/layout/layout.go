@@ -11,6 +11,7 @@ type Composer func(*state.UIState, viewmodel.ViewModel, winsize.Winsize) (*state
 
 type Layout struct {
 	Compose Composer
+	Size    winsize.Transformer
 }
 
 type LayoutBuilder struct {
@@ -31,12 +32,15 @@ func (b *LayoutBuilder) Transformer(transformer winsize.Transformer) *LayoutBuil
 
 func (b *LayoutBuilder) ToLayout() Layout {
 	apply := b.compose
+	size := identity
 	if b.transformer != nil {
 		apply = wrapTransformer(apply, *b.transformer)
+		size = *b.transformer
 	}
 
 	return Layout{
 		Compose: apply,
+		Size:    size,
 	}
 }
 
@@ -46,3 +50,7 @@ func wrapTransformer(compose Composer, transformer winsize.Transformer) Composer
 		return compose(uiState, vm, newSize)
 	}
 }
+
+func identity(size winsize.Winsize) winsize.Winsize {
+	return size
+}
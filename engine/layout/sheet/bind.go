@@ -0,0 +1,208 @@
+package sheet
+
+import (
+	"slices"
+
+	"github.com/Rafael24595/go-reacterm-core/engine/config/padding/cols"
+	"github.com/Rafael24595/go-reacterm-core/engine/helper/runes"
+	"github.com/Rafael24595/go-reacterm-core/engine/layout/transform/padding"
+	"github.com/Rafael24595/go-reacterm-core/engine/model/hint"
+	"github.com/Rafael24595/go-reacterm-core/engine/model/winsize"
+	"github.com/Rafael24595/go-reacterm-core/engine/render/marker"
+	"github.com/Rafael24595/go-reacterm-core/engine/render/style"
+	"github.com/Rafael24595/go-reacterm-core/engine/render/text"
+	"github.com/Rafael24595/go-reacterm-core/engine/render/wrap"
+)
+
+type InitFunc func()
+type DrawFunc func(winsize.Winsize) ([]text.Line, bool)
+type MeasureFunc func(winsize.Winsize) winsize.Winsize
+
+// drawing holds the units being drawn, outermost first. Units draw their
+// children from within their own draw, so while a unit is drawn the
+// stack holds exactly its ancestors. Frames are composed from a single
+// goroutine, which is what makes a shared stack enough.
+var drawing = make([]Target, 0)
+
+// Bind wraps the init and draw of a unit so its lines are styled by the
+// current sheet. Nil funcs are kept nil.
+func Bind(target Target, init InitFunc, draw DrawFunc) (InitFunc, DrawFunc) {
+	if init == nil || draw == nil {
+		return init, draw
+	}
+
+	opened := false
+
+	bindInit := func() {
+		opened = false
+		init()
+	}
+
+	bindDraw := func(size winsize.Winsize) ([]text.Line, bool) {
+		sheet := Current()
+		if sheet == nil {
+			return draw(size)
+		}
+
+		declaration, ok := sheet.Resolve(target, drawing)
+
+		inner := size
+		if ok && declaration.hasBorder() {
+			inner = borderInnerSize(declaration, size)
+		}
+
+		drawing = append(drawing, target)
+		lines, hasNext := draw(inner)
+		drawing = drawing[:len(drawing)-1]
+
+		if !ok {
+			return lines, hasNext
+		}
+
+		lines = declaration.apply(lines)
+		if declaration.hasBorder() {
+			lines = declaration.frame(inner, lines, !opened, !hasNext)
+		}
+
+		opened = true
+
+		return lines, hasNext
+	}
+
+	return bindInit, bindDraw
+}
+
+// BindMeasure wraps the measure of a unit so it accounts for the border
+// the current sheet draws around it. A nil measure is kept nil.
+func BindMeasure(target Target, measure MeasureFunc) MeasureFunc {
+	if measure == nil {
+		return nil
+	}
+
+	return func(size winsize.Winsize) winsize.Winsize {
+		sheet := Current()
+		if sheet == nil {
+			return measure(size)
+		}
+
+		declaration, ok := sheet.Resolve(target, drawing)
+		if !ok || !declaration.hasBorder() {
+			return measure(size)
+		}
+
+		inner := measure(borderInnerSize(declaration, size))
+
+		return winsize.New(
+			min(size.Rows, inner.Rows+2),
+			min(size.Cols, inner.Cols+borderCols(declaration)),
+		)
+	}
+}
+
+func (d Declaration) apply(lines []text.Line) []text.Line {
+	result := make([]text.Line, len(lines))
+
+	for i, line := range lines {
+		line.Text = slices.Clone(line.Text)
+		for j := range line.Text {
+			line.Text[j].AddAtom(d.Atom)
+		}
+
+		line.Paint = d.Paint.Inherit(line.Paint)
+		line.Role = d.Role.Or(line.Role)
+
+		if d.Spec.Kind() != style.SpcKindNone {
+			line.Spec = style.MergeSpec(line.Spec, d.Spec)
+		}
+
+		result[i] = line
+	}
+
+	return result
+}
+
+func borderInnerSize(d Declaration, size winsize.Winsize) winsize.Winsize {
+	return winsize.New(
+		size.Rows.Sub(2),
+		size.Cols.Sub(borderCols(d)),
+	)
+}
+
+func borderCols(d Declaration) winsize.Cols {
+	return runes.Measure(d.Border.Left) + runes.Measure(d.Border.Right)
+}
+
+// frame boxes the lines in the declared border. Units drawn in several
+// chunks only get the top edge on the first one and the bottom edge on
+// the last one.
+func (d Declaration) frame(inner winsize.Winsize, lines []text.Line, top, bottom bool) []text.Line {
+	result := make([]text.Line, 0, len(lines)+2)
+	width := inner.Cols + borderCols(d)
+
+	if top {
+		result = append(result, d.edge(d.Border.Top, d.Border.TopLeft, d.Border.TopRight, width))
+	}
+
+	space := d.Border.Space
+	if space == "" {
+		space = marker.DefaultPaddingText
+	}
+
+	fill := padding.Cols(
+		hint.Fixed(inner.Cols),
+		cols.WithText(space),
+	)
+
+	for _, line := range lines {
+		for _, wrapped := range fill(inner, wrap.Line(inner.Cols, &line)) {
+			frags := make([]text.Fragment, 0, len(wrapped.Text)+2)
+			frags = append(frags, d.side(d.Border.Left))
+			frags = append(frags, wrapped.Text...)
+			frags = append(frags, d.side(d.Border.Right))
+
+			wrapped.Text = frags
+			result = append(result, wrapped)
+		}
+	}
+
+	if bottom {
+		edge := d.Border.Bottom
+		if edge == "" {
+			edge = d.Border.Top
+		}
+		result = append(result, d.edge(edge, d.Border.BottomLeft, d.Border.BottomRight, width))
+	}
+
+	return result
+}
+
+// edge repeats pattern across width, between the corners when the
+// border has them and they fit.
+func (d Declaration) edge(pattern, left, right string, width winsize.Cols) text.Line {
+	corners := runes.Measure(left) + runes.Measure(right)
+	if corners > width {
+		left, right, corners = "", "", 0
+	}
+
+	frags := make([]text.Fragment, 0, 3)
+	if left != "" {
+		frags = append(frags, d.side(left))
+	}
+
+	frags = append(frags, *text.NewFragment(pattern).
+		AddSpec(style.SpecRepeatLeft(width-corners)).
+		SetRole(style.RoleBorder),
+	)
+
+	if right != "" {
+		frags = append(frags, d.side(right))
+	}
+
+	line := text.LineFromFragments(frags...)
+	line.Paint = d.Paint
+	return *line
+}
+
+func (d Declaration) side(pattern string) text.Fragment {
+	return *text.NewFragment(pattern).SetRole(style.RoleBorder)
+}
@@ -0,0 +1,140 @@
+package sheet
+
+import (
+	"fmt"
+	"strings"
+
+	assert "github.com/Rafael24595/go-assert/assert/runtime"
+
+	"github.com/Rafael24595/go-reacterm-core/engine/commons/structure/set"
+)
+
+const (
+	anyName         = "*"
+	tagPrefix       = "."
+	childCombinator = ">"
+)
+
+// Target is a unit as seen by a selector: its name and its tags.
+type Target struct {
+	Name string
+	Tags set.Set[string]
+}
+
+// step matches a single unit. child marks that the unit matched by the
+// previous step must be its direct parent rather than any ancestor.
+type step struct {
+	name  string
+	tags  []string
+	child bool
+}
+
+// Selector picks units the way a CSS selector picks elements: by name,
+// by tags written as ".tag", and by ancestors separated with spaces, or
+// with ">" when the parent must be direct.
+type Selector struct {
+	steps []step
+}
+
+func ParseSelector(selector string) (Selector, error) {
+	steps := make([]step, 0)
+	child := false
+
+	for _, token := range strings.Fields(strings.ReplaceAll(selector, childCombinator, " > ")) {
+		if token == childCombinator {
+			if len(steps) == 0 || child {
+				return Selector{}, fmt.Errorf("misplaced %q in selector %q", childCombinator, selector)
+			}
+			child = true
+			continue
+		}
+
+		parsed, err := parseStep(token)
+		if err != nil {
+			return Selector{}, fmt.Errorf("%w in selector %q", err, selector)
+		}
+
+		parsed.child = child
+		steps = append(steps, parsed)
+		child = false
+	}
+
+	if len(steps) == 0 || child {
+		return Selector{}, fmt.Errorf("incomplete selector %q", selector)
+	}
+
+	return Selector{steps: steps}, nil
+}
+
+// Select parses a selector written in code, where a mistake is a bug.
+func Select(selector string) Selector {
+	parsed, err := ParseSelector(selector)
+	if err != nil {
+		assert.Unreachable("%s", err)
+	}
+	return parsed
+}
+
+func parseStep(token string) (step, error) {
+	parts := strings.Split(token, tagPrefix)
+
+	name := parts[0]
+	if name == anyName {
+		name = ""
+	}
+
+	tags := parts[1:]
+	for _, tag := range tags {
+		if tag == "" {
+			return step{}, fmt.Errorf("empty tag in %q", token)
+		}
+	}
+
+	return step{
+		name: name,
+		tags: tags,
+	}, nil
+}
+
+// Match reports whether target, drawn inside ancestors ordered from the
+// outermost unit to its parent, is picked by the selector.
+func (s Selector) Match(target Target, ancestors []Target) bool {
+	last := len(s.steps) - 1
+	if last < 0 || !s.steps[last].match(target) {
+		return false
+	}
+	return s.matchAncestors(last, ancestors)
+}
+
+func (s Selector) matchAncestors(index int, ancestors []Target) bool {
+	if index == 0 {
+		return true
+	}
+
+	previous := s.steps[index-1]
+	for i := len(ancestors) - 1; i >= 0; i-- {
+		if previous.match(ancestors[i]) && s.matchAncestors(index-1, ancestors[:i]) {
+			return true
+		}
+
+		if s.steps[index].child {
+			return false
+		}
+	}
+
+	return false
+}
+
+func (s step) match(target Target) bool {
+	if s.name != "" && s.name != target.Name {
+		return false
+	}
+
+	for _, tag := range s.tags {
+		if !target.Tags.Has(tag) {
+			return false
+		}
+	}
+
+	return true
+}
@@ -0,0 +1,61 @@
+package sheet
+
+import (
+	"testing"
+
+	assert "github.com/Rafael24595/go-assert/assert/test"
+
+	"github.com/Rafael24595/go-reacterm-core/engine/commons/structure/set"
+)
+
+func target(name string, tags ...string) Target {
+	return Target{
+		Name: name,
+		Tags: set.SetFrom(tags...),
+	}
+}
+
+func TestParseSelector_Invalid(t *testing.T) {
+	for _, selector := range []string{"", "  ", "> a", "a >", "a > > b", "a..b", "a."} {
+		_, err := ParseSelector(selector)
+		assert.NotNil(t, err)
+	}
+}
+
+func TestSelector_Match(t *testing.T) {
+	ancestors := []Target{
+		target("pipeline_unit"),
+		target("vstack_unit", "kernel"),
+		target("box_unit"),
+	}
+
+	tests := []struct {
+		name     string
+		selector string
+		target   Target
+		expected bool
+	}{
+		{"name", "table_unit", target("table_unit"), true},
+		{"other name", "table_unit", target("box_unit"), false},
+		{"tag", ".system_meta", target("line_unit", "system_meta"), true},
+		{"missing tag", ".system_meta", target("line_unit"), false},
+		{"name and tags", "line_unit.a.b", target("line_unit", "a", "b"), true},
+		{"name and missing tag", "line_unit.a.b", target("line_unit", "a"), false},
+		{"any", "*", target("line_unit"), true},
+		{"descendant", "pipeline_unit table_unit", target("table_unit"), true},
+		{"descendant by tag", ".kernel table_unit", target("table_unit"), true},
+		{"missing ancestor", "modal_unit table_unit", target("table_unit"), false},
+		{"child", "box_unit > table_unit", target("table_unit"), true},
+		{"indirect child", "vstack_unit > table_unit", target("table_unit"), false},
+		{"chain", "pipeline_unit .kernel > box_unit table_unit", target("table_unit"), true},
+		{"wrong order", "box_unit pipeline_unit table_unit", target("table_unit"), false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			selector, err := ParseSelector(tt.selector)
+			assert.Nil(t, err)
+			assert.Equal(t, tt.expected, selector.Match(tt.target, ancestors))
+		})
+	}
+}
@@ -0,0 +1,96 @@
+package sheet
+
+import (
+	"sync/atomic"
+
+	"github.com/Rafael24595/go-reacterm-core/engine/render/marker"
+	"github.com/Rafael24595/go-reacterm-core/engine/render/style"
+)
+
+// Declaration is the style a rule gives to the lines of a unit. Atoms
+// add up, the other fields are only applied when set.
+type Declaration struct {
+	Atom   style.Atom
+	Paint  style.Paint
+	Role   style.Role
+	Spec   style.Spec
+	Border marker.BoxSeparatorMeta
+}
+
+type rule struct {
+	selector    Selector
+	declaration Declaration
+}
+
+// Sheet is an ordered list of rules; when several rules match a unit the
+// later ones win.
+type Sheet struct {
+	rules []rule
+}
+
+var current atomic.Pointer[Sheet]
+
+func New() *Sheet {
+	return &Sheet{
+		rules: make([]rule, 0),
+	}
+}
+
+func (s *Sheet) Add(selector Selector, declaration Declaration) *Sheet {
+	s.rules = append(s.rules, rule{
+		selector:    selector,
+		declaration: declaration,
+	})
+	return s
+}
+
+func (s *Sheet) Len() int {
+	return len(s.rules)
+}
+
+// Resolve merges the declarations of every rule matching target.
+func (s *Sheet) Resolve(target Target, ancestors []Target) (Declaration, bool) {
+	result := Declaration{}
+	found := false
+
+	for _, rule := range s.rules {
+		if !rule.selector.Match(target, ancestors) {
+			continue
+		}
+
+		result = result.merge(rule.declaration)
+		found = true
+	}
+
+	return result, found
+}
+
+func (d Declaration) merge(other Declaration) Declaration {
+	d.Atom = style.MergeAtom(d.Atom, other.Atom)
+	d.Paint = other.Paint.Inherit(d.Paint)
+	d.Role = other.Role.Or(d.Role)
+
+	if other.Spec.Kind() != style.SpcKindNone {
+		d.Spec = other.Spec
+	}
+
+	if other.hasBorder() {
+		d.Border = other.Border
+	}
+
+	return d
+}
+
+func (d Declaration) hasBorder() bool {
+	return d.Border != marker.BoxSeparatorMeta{}
+}
+
+// Current is the sheet applied while units are drawn, nil when none is.
+func Current() *Sheet {
+	return current.Load()
+}
+
+// Set switches the active sheet, the next frame is drawn with it.
+func Set(sheet *Sheet) {
+	current.Store(sheet)
+}
@@ -0,0 +1,132 @@
+package sheet
+
+import (
+	"testing"
+
+	assert "github.com/Rafael24595/go-assert/assert/test"
+
+	"github.com/Rafael24595/go-reacterm-core/engine/model/winsize"
+	"github.com/Rafael24595/go-reacterm-core/engine/render/marker"
+	"github.com/Rafael24595/go-reacterm-core/engine/render/style"
+	"github.com/Rafael24595/go-reacterm-core/engine/render/text"
+)
+
+func staticDraw(lines ...text.Line) DrawFunc {
+	return func(winsize.Winsize) ([]text.Line, bool) {
+		return lines, false
+	}
+}
+
+func TestSheet_Resolve(t *testing.T) {
+	sheet := New().
+		Add(Select("*"), Declaration{Atom: style.AtmBold, Role: style.RoleMuted}).
+		Add(Select(".footer"), Declaration{Atom: style.AtmItalic, Role: style.RoleFooter})
+
+	declaration, ok := sheet.Resolve(target("line_unit", "footer"), nil)
+
+	assert.True(t, ok)
+	assert.True(t, declaration.Atom.HasAny(style.AtmBold))
+	assert.True(t, declaration.Atom.HasAny(style.AtmItalic))
+	assert.Equal(t, style.RoleFooter, declaration.Role)
+}
+
+func TestBind_NoSheet(t *testing.T) {
+	Set(nil)
+
+	line := *text.NewLine("golang")
+	_, draw := Bind(target("line_unit"), func() {}, staticDraw(line))
+
+	lines, _ := draw(winsize.New(5, 10))
+
+	assert.Equal(t, "golang", text.LineToString(&lines[0]))
+	assert.True(t, lines[0].Text[0].Atom.HasNone(style.AtmBold))
+}
+
+func TestBind_StylesMatchingUnits(t *testing.T) {
+	defer Set(nil)
+
+	Set(New().Add(Select("outer > line_unit"), Declaration{
+		Atom:  style.AtmBold,
+		Paint: style.Paint{Fg: style.Ansi(style.Red)},
+		Spec:  style.SpecPaddingCenter(10),
+	}))
+
+	line := *text.NewLine("golang")
+	_, inner := Bind(target("line_unit"), func() {}, staticDraw(line))
+	_, outer := Bind(target("outer"), func() {}, inner)
+
+	direct, _ := inner(winsize.New(5, 10))
+	nested, _ := outer(winsize.New(5, 10))
+
+	assert.True(t, direct[0].Text[0].Atom.HasNone(style.AtmBold))
+
+	assert.True(t, nested[0].Text[0].Atom.HasAny(style.AtmBold))
+	assert.Equal(t, style.Ansi(style.Red), nested[0].Paint.Fg)
+	assert.True(t, nested[0].Spec.Kind().HasAny(style.SpcKindPaddingCenter))
+
+	assert.True(t, line.Text[0].Atom.HasNone(style.AtmBold))
+	assert.Len(t, 0, drawing)
+}
+
+func TestBind_Border(t *testing.T) {
+	defer Set(nil)
+
+	Set(New().Add(Select("line_unit"), Declaration{
+		Border: marker.DefaultBoxSeparator,
+	}))
+
+	var received winsize.Winsize
+	init, draw := Bind(target("line_unit"), func() {}, func(size winsize.Winsize) ([]text.Line, bool) {
+		received = size
+		return []text.Line{*text.NewLine("go")}, false
+	})
+
+	init()
+	lines, _ := draw(winsize.New(5, 6))
+
+	assert.Equal(t, winsize.New(3, 4), received)
+	assert.Len(t, 3, lines)
+	assert.Equal(t, "|go |", text.LineToString(&lines[1]))
+	assert.Equal(t, winsize.Cols(6), text.FragmentMeasure(6, lines[1].Text...))
+	assert.Equal(t, style.RoleBorder, lines[1].Text[0].Role)
+}
+
+func TestBind_BorderCorners(t *testing.T) {
+	defer Set(nil)
+
+	Set(New().Add(Select("line_unit"), Declaration{
+		Border: marker.SingleBoxSeparator,
+	}))
+
+	init, draw := Bind(target("line_unit"), func() {}, func(size winsize.Winsize) ([]text.Line, bool) {
+		return []text.Line{*text.NewLine("go")}, false
+	})
+
+	init()
+	lines, _ := draw(winsize.New(5, 6))
+
+	assert.Len(t, 3, lines)
+	assert.Equal(t, "┌", lines[0].Text[0].Text)
+	assert.Equal(t, "┐", lines[0].Text[2].Text)
+	assert.Equal(t, winsize.Cols(6), text.FragmentMeasure(6, lines[0].Text...))
+	assert.Equal(t, "└", lines[2].Text[0].Text)
+}
+
+func TestBindMeasure_Border(t *testing.T) {
+	defer Set(nil)
+
+	content := func(size winsize.Winsize) winsize.Winsize {
+		return winsize.New(1, min(size.Cols, 2))
+	}
+
+	measure := BindMeasure(target("line_unit"), content)
+
+	assert.Equal(t, winsize.New(1, 2), measure(winsize.New(5, 10)))
+
+	Set(New().Add(Select("line_unit"), Declaration{
+		Border: marker.DefaultBoxSeparator,
+	}))
+
+	assert.Equal(t, winsize.New(3, 4), measure(winsize.New(5, 10)))
+	assert.Nil(t, BindMeasure(target("line_unit"), nil))
+}
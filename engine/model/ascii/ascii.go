@@ -9,4 +9,5 @@ const (
 	ENTER_CR   = 0x0D
 	TILDE      = 0x7E
 	BACK_SPACE = 0x08
+	BEL        = 0x07
 )
@@ -8,4 +8,5 @@ const (
 	CTRL_G = 0x07
 	CTRL_T = 0x14
 	CTRL_W = 0x17
+	CTRL_Z = 0x1A
 )
@@ -0,0 +1,27 @@
+package hit
+
+type Target struct {
+	Owner string
+	Index uint16
+	Inner *Target
+}
+
+func New(owner string, index uint16) *Target {
+	return &Target{
+		Owner: owner,
+		Index: index,
+		Inner: nil,
+	}
+}
+
+func Wrap(inner *Target, owner string, index uint16) *Target {
+	return &Target{
+		Owner: owner,
+		Index: index,
+		Inner: inner,
+	}
+}
+
+func (t *Target) Is(owner string) bool {
+	return t != nil && t.Owner == owner
+}
@@ -2,6 +2,7 @@ package input
 
 import (
 	"github.com/Rafael24595/go-reacterm-core/engine/helper/math"
+	"github.com/Rafael24595/go-reacterm-core/engine/helper/runes"
 	"github.com/Rafael24595/go-reacterm-core/engine/model/offset"
 	"github.com/Rafael24595/go-reacterm-core/engine/platform/clock"
 	"github.com/Rafael24595/go-reacterm-core/engine/render/style"
@@ -73,7 +74,7 @@ func (c *TextCursor) MoveCaretTo(buff []rune, caret offset.Offset) {
 		min = 0
 	}
 
-	c.caret = math.Clamp(caret, min, len)
+	c.caret = runes.SnapGrapheme(buff, math.Clamp(caret, min, len))
 	c.anchor = c.caret
 
 	c.status = true
@@ -88,21 +89,37 @@ func (c *TextCursor) MoveSelectTo(buff []rune, caret, anchor offset.Offset) {
 		min = 0
 	}
 
-	c.caret = math.Clamp(caret, min, len)
-	c.anchor = math.Clamp(anchor, min, len)
+	c.caret = runes.SnapGrapheme(buff, math.Clamp(caret, min, len))
+	c.anchor = runes.SnapGrapheme(buff, math.Clamp(anchor, min, len))
 
 	c.status = true
 	c.time = c.clock()
 }
 
-func (c *TextCursor) BlinkStyle() style.Atom {
-	if !c.blink || c.caret != c.anchor {
-		return style.AtmSelect
+// Next returns the offset one grapheme cluster after the caret.
+func (c *TextCursor) Next(buff []rune) offset.Offset {
+	return runes.NextGrapheme(buff, c.caret)
+}
+
+// Prev returns the offset one grapheme cluster before the caret.
+func (c *TextCursor) Prev(buff []rune) offset.Offset {
+	return runes.PrevGrapheme(buff, c.caret)
+}
+
+// BlinkStyle returns the role of the caret: the selection role while a
+// range is selected and the focus role on the visible half of a blink.
+func (c *TextCursor) BlinkStyle() style.Role {
+	if c.caret != c.anchor {
+		return style.RoleSelection
+	}
+
+	if !c.blink {
+		return style.RoleFocus
 	}
 
-	styl := style.AtmNone
+	styl := style.RoleNone
 	if c.status {
-		styl = style.AtmSelect
+		styl = style.RoleFocus
 	}
 
 	now := c.clock()
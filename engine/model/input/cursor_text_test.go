@@ -25,6 +25,24 @@ func TestCursor_SelectionLogic(t *testing.T) {
 	assert.Equal(t, c.Caret(), 6)
 }
 
+func TestCursor_MovesByGrapheme(t *testing.T) {
+	c := NewTextCursor(false)
+	buff := []rune("ae\u0301👍🏽b")
+
+	c.MoveCaretTo(buff, 2)
+	assert.Equal(t, 3, c.Caret())
+
+	c.MoveCaretTo(buff, c.Next(buff))
+	assert.Equal(t, 5, c.Caret())
+
+	c.MoveCaretTo(buff, c.Prev(buff))
+	assert.Equal(t, 3, c.Caret())
+
+	c.MoveSelectTo(buff, 4, 2)
+	assert.Equal(t, 3, c.SelectStart())
+	assert.Equal(t, 5, c.SelectEnd())
+}
+
 func TestCursor_BlinkingLogic(t *testing.T) {
 	clock := &mock.TestClock{Time: 0}
 
@@ -33,12 +51,24 @@ func TestCursor_BlinkingLogic(t *testing.T) {
 
 	clock.Advance(blink_ms + 1)
 
-	assert.Equal(t, c.BlinkStyle(), style.AtmSelect)
+	assert.Equal(t, c.BlinkStyle(), style.RoleFocus)
 
 	clock.Advance(blink_ms + 1)
 
-	assert.Equal(t, c.BlinkStyle(), style.AtmNone)
+	assert.Equal(t, c.BlinkStyle(), style.RoleNone)
 
 	clock.Advance(blink_ms + 1)
-	assert.Equal(t, c.BlinkStyle(), style.AtmSelect)
+	assert.Equal(t, c.BlinkStyle(), style.RoleFocus)
+}
+
+func TestCursor_SelectionRole(t *testing.T) {
+	c := NewTextCursor(true)
+	buff := []rune("Golang")
+
+	c.MoveSelectTo(buff, 3, 1)
+	assert.Equal(t, c.BlinkStyle(), style.RoleSelection)
+
+	c = NewTextCursor(false)
+	c.MoveCaretTo(buff, 2)
+	assert.Equal(t, c.BlinkStyle(), style.RoleFocus)
 }
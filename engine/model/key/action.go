@@ -11,11 +11,13 @@ const (
 
 	ActionEsc
 	ActionExit
+	ActionSuspend
 
 	ActionDeleteBackward
 	ActionDeleteForward
 
 	ActionTab
+	ActionBackTab
 	ActionEnter
 	ActionBackspace
 
@@ -26,11 +28,31 @@ const (
 
 	ActionHome
 	ActionEnd
+	ActionInsert
 	ActionDelete
 
 	ActionPageUp
 	ActionPageDown
 
+	ActionF1
+	ActionF2
+	ActionF3
+	ActionF4
+	ActionF5
+	ActionF6
+	ActionF7
+	ActionF8
+	ActionF9
+	ActionF10
+	ActionF11
+	ActionF12
+
+	ActionMouse
+	ActionWheelUp
+	ActionWheelDown
+
+	ActionPaste
+
 	CustomActionHelp
 	CustomActionBack
 
@@ -43,6 +65,11 @@ const (
 
 	CustomActionPointer
 
+	CustomActionDump
+
+	CustomActionNextTab
+	CustomActionPrevTab
+
 	ActionAll
 )
 
@@ -50,6 +77,7 @@ var ControlKeyMap = map[rune]*Key{
 	ascii.CTRL_A:     NewKeyCode(ActionHome),
 	ascii.CTRL_E:     NewKeyCode(ActionEnd),
 	ascii.CTRL_C:     NewKeyCode(ActionExit),
+	ascii.CTRL_Z:     NewKeyCode(ActionSuspend),
 	ascii.CTRL_W:     NewKeyCode(ActionDeleteBackward),
 	ascii.CTRL_D:     NewKeyCode(ActionDeleteForward),
 	ascii.TAB:        NewKeyCode(ActionTab),
@@ -69,6 +97,12 @@ var AltKeyMap = map[rune]*Key{
 	'z': NewKeyCode(CustomActionUndo, ModAlt),
 	'y': NewKeyCode(CustomActionRedo, ModAlt),
 	'p': NewKeyCode(CustomActionPointer, ModAlt),
+	's': NewKeyCode(CustomActionDump, ModAlt),
+}
+
+var CtrlNamedKeyMap = map[Action]*Key{
+	ActionPageDown: NewKeyCode(CustomActionNextTab, ModCtrl),
+	ActionPageUp:   NewKeyCode(CustomActionPrevTab, ModCtrl),
 }
 
 var CsiFinalMap = map[rune]Action{
@@ -78,14 +112,72 @@ var CsiFinalMap = map[rune]Action{
 	'D': ActionArrowLeft,
 	'H': ActionHome,
 	'F': ActionEnd,
+	'P': ActionF1,
+	'Q': ActionF2,
+	'R': ActionF3,
+	'S': ActionF4,
+	'Z': ActionBackTab,
 }
 
 var CsiTildeMap = map[string]Action{
-	"3": ActionDelete,
-	"1": ActionHome,
-	"7": ActionHome,
-	"4": ActionEnd,
-	"8": ActionEnd,
-	"5": ActionPageUp,
-	"6": ActionPageDown,
+	"2":  ActionInsert,
+	"3":  ActionDelete,
+	"1":  ActionHome,
+	"7":  ActionHome,
+	"4":  ActionEnd,
+	"8":  ActionEnd,
+	"5":  ActionPageUp,
+	"6":  ActionPageDown,
+	"11": ActionF1,
+	"12": ActionF2,
+	"13": ActionF3,
+	"14": ActionF4,
+	"15": ActionF5,
+	"17": ActionF6,
+	"18": ActionF7,
+	"19": ActionF8,
+	"20": ActionF9,
+	"21": ActionF10,
+	"23": ActionF11,
+	"24": ActionF12,
+}
+
+var CsiShiftTildeMap = map[string]Action{
+	"25": ActionF3,
+	"26": ActionF4,
+	"28": ActionF5,
+	"29": ActionF6,
+	"31": ActionF7,
+	"32": ActionF8,
+	"33": ActionF9,
+	"34": ActionF10,
+}
+
+var Ss3Map = map[rune]Action{
+	'A': ActionArrowUp,
+	'B': ActionArrowDown,
+	'C': ActionArrowRight,
+	'D': ActionArrowLeft,
+	'H': ActionHome,
+	'F': ActionEnd,
+	'P': ActionF1,
+	'Q': ActionF2,
+	'R': ActionF3,
+	'S': ActionF4,
+	'M': ActionEnter,
+}
+
+var LinuxFunctionMap = map[rune]Action{
+	'A': ActionF1,
+	'B': ActionF2,
+	'C': ActionF3,
+	'D': ActionF4,
+	'E': ActionF5,
+}
+
+var RxvtArrowMap = map[rune]Action{
+	'a': ActionArrowUp,
+	'b': ActionArrowDown,
+	'c': ActionArrowRight,
+	'd': ActionArrowLeft,
 }
@@ -0,0 +1,30 @@
+package key
+
+import "sync/atomic"
+
+var boundCodes atomic.Pointer[map[Action][]string]
+
+func BindCodes(codes map[Action][]string) {
+	fixed := make(map[Action][]string, len(codes))
+	for action, code := range codes {
+		fixed[action] = append([]string{}, code...)
+	}
+	boundCodes.Store(&fixed)
+}
+
+func BoundCodes(action Action) ([]string, bool) {
+	codes := boundCodes.Load()
+	if codes == nil {
+		return nil, false
+	}
+
+	code, ok := (*codes)[action]
+	return code, ok
+}
+
+func (d Descriptor) Bound(action Action) Descriptor {
+	if code, ok := BoundCodes(action); ok {
+		d.Code = code
+	}
+	return d
+}
@@ -18,16 +18,32 @@ var actionHelpMap = map[Action]Descriptor{
 	ActionHome:       {Code: []string{"HOME", "^A"}, Detail: "Line start"},
 	ActionEnd:        {Code: []string{"END", "^E"}, Detail: "Line end"},
 
-	ActionEnter: {Code: []string{"RET"}, Detail: "New line/Accept"},
-	ActionTab:   {Code: []string{"TAB"}, Detail: "Next field"},
-	ActionEsc:   {Code: []string{"ESC"}, Detail: "Back/Cancel"},
-	ActionExit:  {Code: []string{"^C"}, Detail: "Exit"},
-
+	ActionEnter:   {Code: []string{"RET"}, Detail: "New line/Accept"},
+	ActionTab:     {Code: []string{"TAB"}, Detail: "Next field"},
+	ActionBackTab: {Code: []string{"S-TAB"}, Detail: "Previous field"},
+	ActionEsc:     {Code: []string{"ESC"}, Detail: "Back/Cancel"},
+	ActionExit:    {Code: []string{"^C"}, Detail: "Exit"},
+	ActionSuspend: {Code: []string{"^Z"}, Detail: "Suspend"},
+
+	ActionInsert:         {Code: []string{"INS"}, Detail: "Insert"},
 	ActionBackspace:      {Code: []string{"BS"}, Detail: "Delete char"},
 	ActionDelete:         {Code: []string{"DEL"}, Detail: "Delete forward"},
 	ActionDeleteBackward: {Code: []string{"^W"}, Detail: "Delete word"},
 	ActionDeleteForward:  {Code: []string{"^D"}, Detail: "Delete word fwd"},
 
+	ActionF1:  {Code: []string{"F1"}, Detail: "Function 1"},
+	ActionF2:  {Code: []string{"F2"}, Detail: "Function 2"},
+	ActionF3:  {Code: []string{"F3"}, Detail: "Function 3"},
+	ActionF4:  {Code: []string{"F4"}, Detail: "Function 4"},
+	ActionF5:  {Code: []string{"F5"}, Detail: "Function 5"},
+	ActionF6:  {Code: []string{"F6"}, Detail: "Function 6"},
+	ActionF7:  {Code: []string{"F7"}, Detail: "Function 7"},
+	ActionF8:  {Code: []string{"F8"}, Detail: "Function 8"},
+	ActionF9:  {Code: []string{"F9"}, Detail: "Function 9"},
+	ActionF10: {Code: []string{"F10"}, Detail: "Function 10"},
+	ActionF11: {Code: []string{"F11"}, Detail: "Function 11"},
+	ActionF12: {Code: []string{"F12"}, Detail: "Function 12"},
+
 	CustomActionUndo:  {Code: []string{"^G"}, Detail: "Undo"},
 	CustomActionRedo:  {Code: []string{"^T"}, Detail: "Redo"},
 	CustomActionHelp:  {Code: []string{"M-h"}, Detail: "Help"},
@@ -37,6 +53,16 @@ var actionHelpMap = map[Action]Descriptor{
 	CustomActionPaste: {Code: []string{"M-v"}, Detail: "Paste"},
 
 	CustomActionPointer: {Code: []string{"M-p"}, Detail: "Switch gutter"},
+	CustomActionDump:    {Code: []string{"M-s"}, Detail: "Dump frame"},
+
+	CustomActionNextTab: {Code: []string{"C-PGDN"}, Detail: "Next tab"},
+	CustomActionPrevTab: {Code: []string{"C-PGUP"}, Detail: "Previous tab"},
+
+	ActionMouse:     {Code: []string{"CLICK"}, Detail: "Select"},
+	ActionWheelUp:   {Code: []string{"WHEEL↑"}, Detail: "Scroll up"},
+	ActionWheelDown: {Code: []string{"WHEEL↓"}, Detail: "Scroll down"},
+
+	ActionPaste: {Code: []string{"PASTE"}, Detail: "Paste text"},
 
 	ActionRune: {Code: []string{"Text"}, Detail: "Text"},
 }
@@ -69,11 +95,13 @@ func resolveDescriptor(
 
 	if defaults != nil {
 		if field, exists := defaults[action]; exists {
+			field = field.Bound(action)
 			return &field
 		}
 	}
 
 	if str, exist := actionHelpMap[action]; exist {
+		str = str.Bound(action)
 		return &str
 	}
 
@@ -0,0 +1,62 @@
+package keymap
+
+import "github.com/Rafael24595/go-reacterm-core/engine/model/key"
+
+var actionNames = map[string]key.Action{
+	"exit":            key.ActionExit,
+	"suspend":         key.ActionSuspend,
+	"esc":             key.ActionEsc,
+	"enter":           key.ActionEnter,
+	"tab":             key.ActionTab,
+	"back_tab":        key.ActionBackTab,
+	"backspace":       key.ActionBackspace,
+	"delete":          key.ActionDelete,
+	"delete_backward": key.ActionDeleteBackward,
+	"delete_forward":  key.ActionDeleteForward,
+	"insert":          key.ActionInsert,
+	"home":            key.ActionHome,
+	"end":             key.ActionEnd,
+	"up":              key.ActionArrowUp,
+	"down":            key.ActionArrowDown,
+	"left":            key.ActionArrowLeft,
+	"right":           key.ActionArrowRight,
+	"page_up":         key.ActionPageUp,
+	"page_down":       key.ActionPageDown,
+	"f1":              key.ActionF1,
+	"f2":              key.ActionF2,
+	"f3":              key.ActionF3,
+	"f4":              key.ActionF4,
+	"f5":              key.ActionF5,
+	"f6":              key.ActionF6,
+	"f7":              key.ActionF7,
+	"f8":              key.ActionF8,
+	"f9":              key.ActionF9,
+	"f10":             key.ActionF10,
+	"f11":             key.ActionF11,
+	"f12":             key.ActionF12,
+	"help":            key.CustomActionHelp,
+	"back":            key.CustomActionBack,
+	"undo":            key.CustomActionUndo,
+	"redo":            key.CustomActionRedo,
+	"cut":             key.CustomActionCut,
+	"copy":            key.CustomActionCopy,
+	"paste":           key.CustomActionPaste,
+	"pointer":         key.CustomActionPointer,
+	"dump":            key.CustomActionDump,
+	"next_tab":        key.CustomActionNextTab,
+	"prev_tab":        key.CustomActionPrevTab,
+}
+
+func ParseAction(name string) (key.Action, bool) {
+	action, ok := actionNames[name]
+	return action, ok
+}
+
+func ActionName(action key.Action) (string, bool) {
+	for name, a := range actionNames {
+		if a == action {
+			return name, true
+		}
+	}
+	return "", false
+}
@@ -0,0 +1,201 @@
+package keymap
+
+import (
+	"cmp"
+	"fmt"
+	"slices"
+	"strings"
+	"unicode"
+
+	"github.com/Rafael24595/go-reacterm-core/engine/model/ascii"
+	"github.com/Rafael24595/go-reacterm-core/engine/model/key"
+)
+
+const (
+	chordSeparator = "+"
+	unknownCode    = "???"
+)
+
+type Chord struct {
+	Code key.Action
+	Rune rune
+	Mod  key.ModMask
+}
+
+type namedKey struct {
+	name   string
+	code   string
+	action key.Action
+}
+
+var namedKeys = []namedKey{
+	{"tab", "TAB", key.ActionTab},
+	{"backtab", "S-TAB", key.ActionBackTab},
+	{"enter", "RET", key.ActionEnter},
+	{"ret", "RET", key.ActionEnter},
+	{"esc", "ESC", key.ActionEsc},
+	{"backspace", "BS", key.ActionBackspace},
+	{"delete", "DEL", key.ActionDelete},
+	{"del", "DEL", key.ActionDelete},
+	{"insert", "INS", key.ActionInsert},
+	{"ins", "INS", key.ActionInsert},
+	{"home", "HOME", key.ActionHome},
+	{"end", "END", key.ActionEnd},
+	{"pgup", "PGUP", key.ActionPageUp},
+	{"pageup", "PGUP", key.ActionPageUp},
+	{"pgdn", "PGDN", key.ActionPageDown},
+	{"pagedown", "PGDN", key.ActionPageDown},
+	{"up", "↑", key.ActionArrowUp},
+	{"down", "↓", key.ActionArrowDown},
+	{"left", "←", key.ActionArrowLeft},
+	{"right", "→", key.ActionArrowRight},
+	{"f1", "F1", key.ActionF1},
+	{"f2", "F2", key.ActionF2},
+	{"f3", "F3", key.ActionF3},
+	{"f4", "F4", key.ActionF4},
+	{"f5", "F5", key.ActionF5},
+	{"f6", "F6", key.ActionF6},
+	{"f7", "F7", key.ActionF7},
+	{"f8", "F8", key.ActionF8},
+	{"f9", "F9", key.ActionF9},
+	{"f10", "F10", key.ActionF10},
+	{"f11", "F11", key.ActionF11},
+	{"f12", "F12", key.ActionF12},
+}
+
+var namedRunes = map[string]rune{
+	"space": ascii.SPACE,
+	"plus":  '+',
+}
+
+func NamedChord(code key.Action, mod key.ModMask) Chord {
+	return Chord{
+		Code: code,
+		Rune: 0,
+		Mod:  mod,
+	}
+}
+
+func RuneChord(rn rune, mod key.ModMask) Chord {
+	if unicode.IsLetter(rn) && mod.HasAny(key.ModShift) {
+		rn = unicode.ToUpper(rn)
+	}
+
+	if unicode.IsUpper(rn) {
+		mod &^= key.ModShift
+	}
+
+	return Chord{
+		Code: key.ActionRune,
+		Rune: rn,
+		Mod:  mod,
+	}
+}
+
+func ControlChord(rn rune) (Chord, bool) {
+	switch rn {
+	case ascii.TAB:
+		return NamedChord(key.ActionTab, key.ModNone), true
+	case ascii.ENTER_LF, ascii.ENTER_CR:
+		return NamedChord(key.ActionEnter, key.ModNone), true
+	case ascii.DEL, ascii.BACK_SPACE:
+		return NamedChord(key.ActionBackspace, key.ModNone), true
+	}
+
+	if rn >= ascii.CTRL_A && rn <= ascii.CTRL_A+'z'-'a' {
+		return RuneChord('a'+rn-ascii.CTRL_A, key.ModCtrl), true
+	}
+
+	return Chord{}, false
+}
+
+func ParseChord(value string) (Chord, error) {
+	parts := strings.Split(strings.ToLower(strings.TrimSpace(value)), chordSeparator)
+
+	mod := key.ModNone
+	for _, part := range parts[:len(parts)-1] {
+		switch strings.TrimSpace(part) {
+		case "ctrl", "control", "c":
+			mod |= key.ModCtrl
+		case "alt", "meta", "m":
+			mod |= key.ModAlt
+		case "shift", "s":
+			mod |= key.ModShift
+		default:
+			return Chord{}, fmt.Errorf("unknown modifier %q in chord %q", part, value)
+		}
+	}
+
+	name := strings.TrimSpace(parts[len(parts)-1])
+
+	for _, named := range namedKeys {
+		if named.name == name {
+			return NamedChord(named.action, mod), nil
+		}
+	}
+
+	if rn, ok := namedRunes[name]; ok {
+		return RuneChord(rn, mod), nil
+	}
+
+	rns := []rune(name)
+	if len(rns) != 1 || !unicode.IsPrint(rns[0]) {
+		return Chord{}, fmt.Errorf("unknown key %q in chord %q", name, value)
+	}
+
+	if mod == key.ModNone || mod == key.ModShift {
+		return Chord{}, fmt.Errorf("chord %q shadows a printable character", value)
+	}
+
+	return RuneChord(rns[0], mod), nil
+}
+
+func (c Chord) String() string {
+	if c.Code != key.ActionRune {
+		return modPrefix(c.Mod) + namedCode(c.Code)
+	}
+
+	rn := string(c.Rune)
+	if c.Rune == ascii.SPACE {
+		rn = "SPC"
+	}
+
+	if c.Mod == key.ModCtrl && unicode.IsLower(c.Rune) {
+		return "^" + strings.ToUpper(rn)
+	}
+
+	return modPrefix(c.Mod) + rn
+}
+
+func modPrefix(mod key.ModMask) string {
+	var prefix strings.Builder
+	if mod.HasAny(key.ModCtrl) {
+		prefix.WriteString("C-")
+	}
+	if mod.HasAny(key.ModAlt) {
+		prefix.WriteString("M-")
+	}
+	if mod.HasAny(key.ModShift) {
+		prefix.WriteString("S-")
+	}
+	return prefix.String()
+}
+
+func namedCode(action key.Action) string {
+	for _, named := range namedKeys {
+		if named.action == action {
+			return named.code
+		}
+	}
+	return unknownCode
+}
+
+func sortChords(chords []Chord) {
+	slices.SortFunc(chords, func(a, b Chord) int {
+		return cmp.Or(
+			cmp.Compare(a.Mod, b.Mod),
+			cmp.Compare(a.Code, b.Code),
+			cmp.Compare(a.Rune, b.Rune),
+		)
+	})
+}
@@ -0,0 +1,135 @@
+package keymap
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/Rafael24595/go-reacterm-core/engine/model/key"
+)
+
+type ConflictError struct {
+	Chord   Chord
+	Actions []key.Action
+}
+
+func (e *ConflictError) Error() string {
+	names := make([]string, len(e.Actions))
+	for i, action := range e.Actions {
+		names[i] = actionLabel(action)
+	}
+	return fmt.Sprintf("chord %s is bound to more than one action: %s", e.Chord, strings.Join(names, ", "))
+}
+
+type Keymap struct {
+	bindings map[Chord]*key.Key
+	user     map[Chord]key.Action
+	rebound  map[key.Action]bool
+}
+
+func New() *Keymap {
+	return &Keymap{
+		bindings: make(map[Chord]*key.Key),
+		user:     make(map[Chord]key.Action),
+		rebound:  make(map[key.Action]bool),
+	}
+}
+
+func Default() *Keymap {
+	km := New()
+
+	for rn, ky := range key.ControlKeyMap {
+		if chord, ok := ControlChord(rn); ok {
+			km.bindings[chord] = ky
+		}
+	}
+
+	for rn, ky := range key.AltKeyMap {
+		km.bindings[RuneChord(rn, key.ModAlt)] = ky
+	}
+
+	for code, ky := range key.CtrlNamedKeyMap {
+		km.bindings[NamedChord(code, key.ModCtrl)] = ky
+	}
+
+	return km
+}
+
+func (k *Keymap) Bind(action key.Action, chords ...Chord) error {
+	for chord, ky := range k.bindings {
+		if ky.Code == action && k.user[chord] != action {
+			delete(k.bindings, chord)
+		}
+	}
+
+	k.rebound[action] = true
+
+	var errs []error
+	for _, chord := range chords {
+		if owner, ok := k.user[chord]; ok && owner != action {
+			errs = append(errs, &ConflictError{
+				Chord:   chord,
+				Actions: []key.Action{owner, action},
+			})
+			continue
+		}
+
+		if ky, ok := k.bindings[chord]; ok && ky.Code != action {
+			k.rebound[ky.Code] = true
+		}
+
+		k.bindings[chord] = key.NewKeyCode(action, chord.Mod)
+		k.user[chord] = action
+	}
+
+	return errors.Join(errs...)
+}
+
+func (k *Keymap) Resolve(chord Chord) (*key.Key, bool) {
+	ky, ok := k.bindings[chord]
+	return ky, ok
+}
+
+func (k *Keymap) Chords(action key.Action) []Chord {
+	chords := make([]Chord, 0)
+	for chord, ky := range k.bindings {
+		if ky.Code == action {
+			chords = append(chords, chord)
+		}
+	}
+
+	sortChords(chords)
+
+	return chords
+}
+
+func (k *Keymap) Codes() map[key.Action][]string {
+	codes := make(map[key.Action][]string, len(k.rebound))
+	for action := range k.rebound {
+		code := make([]string, 0)
+
+		native := NamedChord(action, key.ModNone)
+		if _, ok := k.bindings[native]; !ok && namedCode(action) != unknownCode {
+			code = append(code, namedCode(action))
+		}
+
+		for _, chord := range k.Chords(action) {
+			code = append(code, chord.String())
+		}
+
+		codes[action] = code
+	}
+
+	return codes
+}
+
+func (k *Keymap) Apply() {
+	key.BindCodes(k.Codes())
+}
+
+func actionLabel(action key.Action) string {
+	if name, ok := ActionName(action); ok {
+		return name
+	}
+	return fmt.Sprintf("action(%d)", action)
+}
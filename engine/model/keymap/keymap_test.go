@@ -0,0 +1,119 @@
+package keymap
+
+import (
+	"errors"
+	"os"
+	"path/filepath"
+	"testing"
+
+	assert "github.com/Rafael24595/go-assert/assert/test"
+
+	"github.com/Rafael24595/go-reacterm-core/engine/commons/conf"
+	"github.com/Rafael24595/go-reacterm-core/engine/model/key"
+)
+
+func TestParseChord(t *testing.T) {
+	tests := []struct {
+		input string
+		chord Chord
+		code  string
+	}{
+		{"ctrl+c", RuneChord('c', key.ModCtrl), "^C"},
+		{"ctrl+shift+c", RuneChord('C', key.ModCtrl), "C-C"},
+		{"Alt+X", RuneChord('x', key.ModAlt), "M-x"},
+		{"ctrl+alt+space", RuneChord(' ', key.ModCtrl|key.ModAlt), "C-M-SPC"},
+		{"f5", NamedChord(key.ActionF5, key.ModNone), "F5"},
+		{"shift+pgup", NamedChord(key.ActionPageUp, key.ModShift), "S-PGUP"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.input, func(t *testing.T) {
+			chord, err := ParseChord(tt.input)
+
+			assert.Nil(t, err)
+			assert.Equal(t, tt.chord, chord)
+			assert.Equal(t, tt.code, chord.String())
+		})
+	}
+}
+
+func TestParseChord_Invalid(t *testing.T) {
+	for _, input := range []string{"", "hyper+a", "ctrl+foo", "a", "shift+a"} {
+		_, err := ParseChord(input)
+		assert.NotNil(t, err)
+	}
+}
+
+func TestParse_JSON(t *testing.T) {
+	data := []byte(`{"copy": "ctrl+shift+c", "undo": ["ctrl+z", "f2"]}`)
+
+	km, err := Parse(data, conf.FormatJSON)
+	assert.Nil(t, err)
+
+	ky, ok := km.Resolve(RuneChord('C', key.ModCtrl))
+	assert.True(t, ok)
+	assert.Equal(t, key.CustomActionCopy, ky.Code)
+
+	ky, ok = km.Resolve(NamedChord(key.ActionF2, key.ModNone))
+	assert.True(t, ok)
+	assert.Equal(t, key.CustomActionUndo, ky.Code)
+
+	_, ok = km.Resolve(RuneChord('c', key.ModAlt))
+	assert.False(t, ok)
+}
+
+func TestLoad_TOML(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "keys.toml")
+	data := "# user keys\n[keys]\ncopy = \"ctrl+shift+c\" # copy\nhelp = [\"f1\", \"alt+?\"]\n"
+	assert.Nil(t, os.WriteFile(path, []byte(data), 0o600))
+
+	km, err := Load(path)
+	assert.Nil(t, err)
+
+	ky, ok := km.Resolve(RuneChord('?', key.ModAlt))
+	assert.True(t, ok)
+	assert.Equal(t, key.CustomActionHelp, ky.Code)
+}
+
+func TestParse_Conflict(t *testing.T) {
+	data := []byte(`{"copy": "ctrl+k", "cut": "ctrl+k"}`)
+
+	_, err := Parse(data, conf.FormatJSON)
+
+	var conflict *ConflictError
+	assert.True(t, errors.As(err, &conflict))
+	assert.Equal(t, RuneChord('k', key.ModCtrl), conflict.Chord)
+}
+
+func TestParse_UnknownAction(t *testing.T) {
+	_, err := Parse([]byte(`{"fly": "ctrl+k"}`), conf.FormatJSON)
+	assert.NotNil(t, err)
+}
+
+func TestCodes(t *testing.T) {
+	km := Default()
+	assert.Nil(t, km.Bind(key.CustomActionCopy, RuneChord('a', key.ModCtrl)))
+
+	codes := km.Codes()
+
+	assert.DeepEqual(t, []string{"^A"}, codes[key.CustomActionCopy])
+	assert.DeepEqual(t, []string{"HOME"}, codes[key.ActionHome])
+
+	_, ok := codes[key.ActionEnd]
+	assert.False(t, ok)
+}
+
+func TestDefault_TabChords(t *testing.T) {
+	km := Default()
+
+	next, ok := km.Resolve(NamedChord(key.ActionPageDown, key.ModCtrl))
+	assert.True(t, ok)
+	assert.Equal(t, key.CustomActionNextTab, next.Code)
+
+	prev, ok := km.Resolve(NamedChord(key.ActionPageUp, key.ModCtrl))
+	assert.True(t, ok)
+	assert.Equal(t, key.CustomActionPrevTab, prev.Code)
+
+	_, ok = km.Resolve(NamedChord(key.ActionPageUp, key.ModNone))
+	assert.False(t, ok)
+}
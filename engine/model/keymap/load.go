@@ -0,0 +1,100 @@
+package keymap
+
+import (
+	"errors"
+	"fmt"
+	"slices"
+
+	"github.com/Rafael24595/go-reacterm-core/engine/commons/conf"
+)
+
+func Load(path string) (*Keymap, error) {
+	values, err := conf.DecodeFile(path)
+	if err != nil {
+		return nil, err
+	}
+
+	return fromValues(values)
+}
+
+func Parse(data []byte, format conf.Format) (*Keymap, error) {
+	values, err := conf.Decode(data, format)
+	if err != nil {
+		return nil, err
+	}
+
+	return fromValues(values)
+}
+
+func fromValues(values map[string]any) (*Keymap, error) {
+	entries := make(map[string][]string, len(values))
+	if err := collectEntries(entries, values); err != nil {
+		return nil, err
+	}
+
+	return FromEntries(entries)
+}
+
+// collectEntries flattens nested tables, so bindings may be grouped in
+// sections such as [keys] without the section taking part in the name.
+func collectEntries(entries map[string][]string, values map[string]any) error {
+	for name, value := range values {
+		if table, ok := conf.Table(value); ok {
+			if err := collectEntries(entries, table); err != nil {
+				return err
+			}
+			continue
+		}
+
+		chords, ok := conf.Strings(value)
+		if !ok {
+			return fmt.Errorf("action %q: expected a string or a list of strings", name)
+		}
+
+		if _, exists := entries[name]; exists {
+			return fmt.Errorf("duplicate action %q", name)
+		}
+		entries[name] = chords
+	}
+
+	return nil
+}
+
+func FromEntries(entries map[string][]string) (*Keymap, error) {
+	names := make([]string, 0, len(entries))
+	for name := range entries {
+		names = append(names, name)
+	}
+	slices.Sort(names)
+
+	km := Default()
+
+	var errs []error
+	for _, name := range names {
+		action, ok := ParseAction(name)
+		if !ok {
+			errs = append(errs, fmt.Errorf("unknown action %q", name))
+			continue
+		}
+
+		chords := make([]Chord, 0, len(entries[name]))
+		for _, value := range entries[name] {
+			chord, err := ParseChord(value)
+			if err != nil {
+				errs = append(errs, fmt.Errorf("action %q: %w", name, err))
+				continue
+			}
+			chords = append(chords, chord)
+		}
+
+		if err := km.Bind(action, chords...); err != nil {
+			errs = append(errs, err)
+		}
+	}
+
+	if err := errors.Join(errs...); err != nil {
+		return nil, err
+	}
+
+	return km, nil
+}
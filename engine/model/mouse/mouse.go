@@ -0,0 +1,67 @@
+package mouse
+
+import (
+	"github.com/Rafael24595/go-reacterm-core/engine/model/key"
+	"github.com/Rafael24595/go-reacterm-core/engine/model/winsize"
+)
+
+type Button uint8
+
+const (
+	ButtonNone Button = iota
+	ButtonLeft
+	ButtonMiddle
+	ButtonRight
+	ButtonWheelUp
+	ButtonWheelDown
+	ButtonWheelLeft
+	ButtonWheelRight
+)
+
+type Kind uint8
+
+const (
+	KindPress Kind = iota
+	KindRelease
+	KindDrag
+	KindWheel
+)
+
+type Mouse struct {
+	Kind   Kind
+	Button Button
+	Mod    key.ModMask
+	Row    winsize.Rows
+	Col    winsize.Cols
+}
+
+func New(kind Kind, button Button, row winsize.Rows, col winsize.Cols, mods ...key.ModMask) *Mouse {
+	return &Mouse{
+		Kind:   kind,
+		Button: button,
+		Mod:    key.MergeMods(mods...),
+		Row:    row,
+		Col:    col,
+	}
+}
+
+func (m Mouse) IsClick() bool {
+	return m.Kind == KindPress && m.Button == ButtonLeft
+}
+
+func (m Mouse) IsWheel() bool {
+	return m.Kind == KindWheel
+}
+
+func (m Mouse) ToKey() key.Key {
+	action := key.ActionMouse
+
+	switch m.Button {
+	case ButtonWheelUp:
+		action = key.ActionWheelUp
+	case ButtonWheelDown:
+		action = key.ActionWheelDown
+	}
+
+	return *key.NewKeyCode(action, m.Mod)
+}
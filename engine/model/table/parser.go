@@ -5,6 +5,7 @@ import "reflect"
 type Field struct {
 	Header string
 	Value  any
+	Link   string
 }
 
 func StructHeaders[T any]() []string {
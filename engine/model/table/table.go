@@ -11,6 +11,7 @@ import (
 
 type Table struct {
 	cols      map[string][]string
+	links     map[string][]string
 	headers   []string
 	separator marker.TableSeparatorMeta
 }
@@ -19,6 +20,7 @@ func NewTable() *Table {
 	return &Table{
 		headers:   make([]string, 0),
 		cols:      make(map[string][]string),
+		links:     make(map[string][]string),
 		separator: marker.DefaultTableSeparator,
 	}
 }
@@ -87,6 +89,30 @@ func (t *Table) SetCell(header string, row uint16, data any) *Table {
 	return t
 }
 
+func (t *Table) GetLinks() map[string][]string {
+	return t.links
+}
+
+func (t *Table) SetLink(header string, row uint16, uri string) *Table {
+	if _, ok := t.cols[header]; !ok {
+		return t
+	}
+
+	link := t.links[header]
+
+	linkLen := uint16(len(link))
+	if row >= linkLen {
+		for i := linkLen; i <= row; i++ {
+			link = append(link, "")
+		}
+	}
+
+	link[row] = uri
+	t.links[header] = link
+
+	return t
+}
+
 func (t *Table) Size() map[string]winsize.Cols {
 	size := make(map[string]winsize.Cols)
 	for _, h := range t.headers {
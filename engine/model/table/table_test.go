@@ -45,6 +45,21 @@ func TestField_ShouldExpandRowsDynamically(t *testing.T) {
 	assert.Equal(t, "Golang", col[2])
 }
 
+func TestSetLink_ShouldExpandRowsDynamically(t *testing.T) {
+	tbl := NewTable()
+	tbl.SetHeaders("Name")
+
+	tbl.SetLink("Name", 1, "https://go.dev")
+	tbl.SetLink("Invalid", 0, "https://x.io")
+
+	links := tbl.GetLinks()
+
+	assert.Len(t, 2, links["Name"])
+	assert.Equal(t, "", links["Name"][0])
+	assert.Equal(t, "https://go.dev", links["Name"][1])
+	assert.Len(t, 0, links["Invalid"])
+}
+
 func TestField_WithInvalidHeader_ShouldDoNothing(t *testing.T) {
 	tbl := NewTable()
 	tbl.SetHeaders("ID")
@@ -1,17 +1,133 @@
 package marker
 
+import "github.com/Rafael24595/go-reacterm-core/engine/terminal/capability"
+
 type BoxSeparatorMeta struct {
-	Top    string
-	Bottom string
-	Left   string
-	Right  string
-	Space  string
+	Top         string
+	Bottom      string
+	Left        string
+	Right       string
+	Space       string
+	TopLeft     string
+	TopRight    string
+	BottomLeft  string
+	BottomRight string
 }
 
 var DefaultBoxSeparator = BoxSeparatorMeta{
-	Top:    "-",
-	Bottom: "-",
-	Left:   "|",
-	Right:  "|",
-	Space:  " ",
+	Top:         "-",
+	Bottom:      "-",
+	Left:        "|",
+	Right:       "|",
+	Space:       " ",
+	TopLeft:     "-",
+	TopRight:    "-",
+	BottomLeft:  "-",
+	BottomRight: "-",
+}
+
+type BoxStyle uint8
+
+const (
+	BoxAscii BoxStyle = iota
+	BoxSingle
+	BoxDouble
+	BoxRounded
+	BoxThick
+	BoxDashed
+)
+
+var AsciiBoxSeparator = BoxSeparatorMeta{
+	Top:         "-",
+	Bottom:      "-",
+	Left:        "|",
+	Right:       "|",
+	Space:       " ",
+	TopLeft:     "+",
+	TopRight:    "+",
+	BottomLeft:  "+",
+	BottomRight: "+",
+}
+
+var SingleBoxSeparator = BoxSeparatorMeta{
+	Top:         "─",
+	Bottom:      "─",
+	Left:        "│",
+	Right:       "│",
+	Space:       " ",
+	TopLeft:     "┌",
+	TopRight:    "┐",
+	BottomLeft:  "└",
+	BottomRight: "┘",
+}
+
+var DoubleBoxSeparator = BoxSeparatorMeta{
+	Top:         "═",
+	Bottom:      "═",
+	Left:        "║",
+	Right:       "║",
+	Space:       " ",
+	TopLeft:     "╔",
+	TopRight:    "╗",
+	BottomLeft:  "╚",
+	BottomRight: "╝",
+}
+
+var RoundedBoxSeparator = BoxSeparatorMeta{
+	Top:         "─",
+	Bottom:      "─",
+	Left:        "│",
+	Right:       "│",
+	Space:       " ",
+	TopLeft:     "╭",
+	TopRight:    "╮",
+	BottomLeft:  "╰",
+	BottomRight: "╯",
+}
+
+var ThickBoxSeparator = BoxSeparatorMeta{
+	Top:         "━",
+	Bottom:      "━",
+	Left:        "┃",
+	Right:       "┃",
+	Space:       " ",
+	TopLeft:     "┏",
+	TopRight:    "┓",
+	BottomLeft:  "┗",
+	BottomRight: "┛",
+}
+
+var DashedBoxSeparator = BoxSeparatorMeta{
+	Top:         "╌",
+	Bottom:      "╌",
+	Left:        "╎",
+	Right:       "╎",
+	Space:       " ",
+	TopLeft:     "┌",
+	TopRight:    "┐",
+	BottomLeft:  "└",
+	BottomRight: "┘",
+}
+
+// BoxSeparator returns the separator of a preset, falling back to the
+// ASCII one on terminals without unicode.
+func BoxSeparator(preset BoxStyle) BoxSeparatorMeta {
+	if !capability.Current().Unicode {
+		return AsciiBoxSeparator
+	}
+
+	switch preset {
+	case BoxSingle:
+		return SingleBoxSeparator
+	case BoxDouble:
+		return DoubleBoxSeparator
+	case BoxRounded:
+		return RoundedBoxSeparator
+	case BoxThick:
+		return ThickBoxSeparator
+	case BoxDashed:
+		return DashedBoxSeparator
+	}
+
+	return AsciiBoxSeparator
 }
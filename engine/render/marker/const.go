@@ -1,6 +1,9 @@
 package marker
 
-import "github.com/Rafael24595/go-reacterm-core/engine/model/winsize"
+import (
+	"github.com/Rafael24595/go-reacterm-core/engine/model/winsize"
+	"github.com/Rafael24595/go-reacterm-core/engine/terminal/capability"
+)
 
 const DefaultPaddingText = " "
 
@@ -17,4 +20,58 @@ const (
 	DefaultRightGutterText  = "▐"
 )
 
+const (
+	AsciiLeftGutterText   = "|"
+	AsciiMiddleGutterText = "|"
+	AsciiRightGutterText  = "|"
+)
+
+const (
+	DefaultScrollTrackText = "│"
+	DefaultScrollThumbText = "┃"
+)
+
+const (
+	AsciiScrollTrackText = "|"
+	AsciiScrollThumbText = "#"
+)
+
+const (
+	DefaultDividerVerticalText   = "│"
+	DefaultDividerHorizontalText = "─"
+)
+
+const (
+	AsciiDividerVerticalText   = "|"
+	AsciiDividerHorizontalText = "-"
+)
+
 var PrintableCaretRunes = []rune(PrintableCaretText)
+
+func LeftGutterText() string {
+	return capability.Glyph(DefaultLeftGutterText, AsciiLeftGutterText)
+}
+
+func MiddleGutterText() string {
+	return capability.Glyph(DefaultMiddleGutterText, AsciiMiddleGutterText)
+}
+
+func RightGutterText() string {
+	return capability.Glyph(DefaultRightGutterText, AsciiRightGutterText)
+}
+
+func ScrollTrackText() string {
+	return capability.Glyph(DefaultScrollTrackText, AsciiScrollTrackText)
+}
+
+func ScrollThumbText() string {
+	return capability.Glyph(DefaultScrollThumbText, AsciiScrollThumbText)
+}
+
+func DividerVerticalText() string {
+	return capability.Glyph(DefaultDividerVerticalText, AsciiDividerVerticalText)
+}
+
+func DividerHorizontalText() string {
+	return capability.Glyph(DefaultDividerHorizontalText, AsciiDividerHorizontalText)
+}
@@ -0,0 +1,93 @@
+package processor
+
+import (
+	"html"
+	"strings"
+
+	"github.com/Rafael24595/go-reacterm-core/engine/model/winsize"
+	"github.com/Rafael24595/go-reacterm-core/engine/render/styler"
+	"github.com/Rafael24595/go-reacterm-core/engine/render/text"
+	"github.com/Rafael24595/go-reacterm-core/engine/render/theme"
+)
+
+const defaultMarkupTitle = "frame"
+
+// HTML renders a frame as a standalone page holding a single pre block,
+// so it can be opened, searched and copied like any other document.
+type HTML struct {
+	atom  styler.Atom
+	spec  styler.Spec
+	theme *theme.Theme
+	title string
+}
+
+func NewHTML(spec styler.Spec) HTML {
+	return HTML{
+		atom:  *styler.NewDefaultAtom(),
+		spec:  spec,
+		theme: nil,
+		title: defaultMarkupTitle,
+	}
+}
+
+// Theme pins the processor to a theme, nil follows theme.Current.
+func (r HTML) Theme(theme *theme.Theme) HTML {
+	r.theme = theme
+	return r
+}
+
+func (r HTML) Title(title string) HTML {
+	r.title = title
+	return r
+}
+
+func (r HTML) Render(lines []text.Line, size winsize.Winsize) string {
+	var buffer strings.Builder
+
+	foreground := cssColor(markupForeground)
+	background := cssColor(markupBackground)
+
+	buffer.WriteString("<!DOCTYPE html>\n<html>\n<head>\n<meta charset=\"utf-8\">\n")
+	buffer.WriteString("<title>" + html.EscapeString(r.title) + "</title>\n</head>\n")
+	buffer.WriteString("<body style=\"margin:0;background:" + background + "\">\n")
+	buffer.WriteString("<pre style=\"margin:0;padding:1ch;font-family:monospace;")
+	buffer.WriteString("color:" + foreground + ";background:" + background + "\">\n")
+
+	active := resolveTheme(r.theme)
+	for i, line := range lines {
+		if i > 0 {
+			buffer.WriteString("\n")
+		}
+
+		line = active.ResolveLine(line)
+		for _, span := range layoutSpans(r.spec, line, size) {
+			buffer.WriteString(r.renderSpan(span))
+		}
+	}
+
+	buffer.WriteString("\n</pre>\n</body>\n</html>\n")
+
+	return buffer.String()
+}
+
+func (r HTML) renderSpan(span span) string {
+	content := html.EscapeString(r.atom.Apply(span.text, span.group.atom))
+	if content == "" {
+		return content
+	}
+
+	declarations := markupDeclarations(span.group, "color")
+	if bg := markupPaint(span.group).Bg; !bg.IsNone() {
+		declarations = append(declarations, "background:"+cssColor(bg))
+	}
+
+	if len(declarations) != 0 {
+		content = "<span style=\"" + strings.Join(declarations, ";") + "\">" + content + "</span>"
+	}
+
+	if span.group.link != "" {
+		content = "<a href=\"" + html.EscapeString(span.group.link) + "\" style=\"color:inherit\">" + content + "</a>"
+	}
+
+	return content
+}
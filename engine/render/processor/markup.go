@@ -0,0 +1,87 @@
+package processor
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/Rafael24595/go-reacterm-core/engine/render/style"
+)
+
+// Colours used where the frame leaves them to the terminal.
+var (
+	markupForeground = style.Ansi(style.White)
+	markupBackground = style.Ansi(style.Black)
+)
+
+func cssColor(color style.Color) string {
+	r, g, b := color.ToRGB().Components()
+	return fmt.Sprintf("#%02x%02x%02x", r, g, b)
+}
+
+// markupPaint resolves the colours a group is drawn with, swapping them
+// for selected text as a reverse-video terminal would.
+func markupPaint(group group) style.Paint {
+	if group.atom.HasNone(style.AtmSelect) {
+		return group.paint
+	}
+
+	return style.Paint{
+		Fg: group.paint.Bg.Or(markupBackground),
+		Bg: group.paint.Fg.Or(markupForeground),
+	}
+}
+
+// markupDeclarations lists the CSS for the atoms of a group. The colour
+// property differs between HTML and SVG, so it is given by the caller.
+func markupDeclarations(group group, colorProperty string) []string {
+	declarations := make([]string, 0)
+
+	paint := markupPaint(group)
+	if !paint.Fg.IsNone() {
+		declarations = append(declarations, colorProperty+":"+cssColor(paint.Fg))
+	}
+
+	if group.atom.HasAny(style.AtmBold) {
+		declarations = append(declarations, "font-weight:bold")
+	}
+
+	if group.atom.HasAny(style.AtmDim) {
+		declarations = append(declarations, "opacity:0.6")
+	}
+
+	if group.atom.HasAny(style.AtmItalic) {
+		declarations = append(declarations, "font-style:italic")
+	}
+
+	if decoration := markupDecoration(group.atom); decoration != "" {
+		declarations = append(declarations, "text-decoration:"+decoration)
+	}
+
+	return declarations
+}
+
+func markupDecoration(atom style.Atom) string {
+	lines := make([]string, 0)
+	if atom.HasAny(style.AtmUnderline, style.AtmCurlyUnderline, style.AtmDoubleUnderline) {
+		lines = append(lines, "underline")
+	}
+	if atom.HasAny(style.AtmStrike) {
+		lines = append(lines, "line-through")
+	}
+	if atom.HasAny(style.AtmOverline) {
+		lines = append(lines, "overline")
+	}
+
+	if len(lines) == 0 {
+		return ""
+	}
+
+	switch {
+	case atom.HasAny(style.AtmCurlyUnderline):
+		lines = append(lines, "wavy")
+	case atom.HasAny(style.AtmDoubleUnderline):
+		lines = append(lines, "double")
+	}
+
+	return strings.Join(lines, " ")
+}
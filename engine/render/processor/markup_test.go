@@ -0,0 +1,84 @@
+package processor
+
+import (
+	"strings"
+	"testing"
+
+	assert "github.com/Rafael24595/go-assert/assert/test"
+
+	"github.com/Rafael24595/go-reacterm-core/engine/model/winsize"
+	"github.com/Rafael24595/go-reacterm-core/engine/render/style"
+	"github.com/Rafael24595/go-reacterm-core/engine/render/styler"
+	"github.com/Rafael24595/go-reacterm-core/engine/render/text"
+	"github.com/Rafael24595/go-reacterm-core/engine/render/theme"
+)
+
+func TestLayoutSpans_Padding(t *testing.T) {
+	line := text.LineFromFragments(
+		*text.NewFragment("ab").AddAtom(style.AtmBold),
+		*text.NewFragment("c"),
+	).SetSpec(style.SpecPaddingCenter(7))
+
+	spans := layoutSpans(*styler.NewDefaultSpec(), *line, winsize.New(1, 7))
+
+	assert.Len(t, 3, spans)
+	assert.Equal(t, "  ", spans[0].text)
+	assert.Equal(t, "ab", spans[1].text)
+	assert.True(t, spans[1].group.atom.HasAny(style.AtmBold))
+	assert.Equal(t, "c  ", spans[2].text)
+}
+
+func TestLayoutSpans_Fill(t *testing.T) {
+	line := text.NewLine("=", style.SpecFromKind(style.SpcKindFill))
+
+	spans := layoutSpans(*styler.NewDefaultSpec(), *line, winsize.New(1, 4))
+
+	assert.Len(t, 1, spans)
+	assert.Equal(t, "====", spans[0].text)
+}
+
+func TestHTML_Render(t *testing.T) {
+	line := text.LineFromFragments(
+		*text.NewFragment("<b>").AddAtom(style.AtmBold),
+		*text.NewFragment(" "),
+		*text.NewFragment("go").SetLink("https://go.dev"),
+		*text.NewFragment("red").SetPaint(style.Paint{Fg: style.Ansi(style.Red)}),
+	)
+
+	output := NewHTML(*styler.NewDefaultSpec()).
+		Theme(theme.Default()).
+		Render([]text.Line{*line}, winsize.New(1, 20))
+
+	assert.True(t, strings.HasPrefix(output, "<!DOCTYPE html>"))
+	assert.True(t, strings.Contains(output, `<span style="font-weight:bold">&lt;b&gt;</span> `))
+	assert.True(t, strings.Contains(output, `<a href="https://go.dev" style="color:inherit">go</a>`))
+	assert.True(t, strings.Contains(output, `<span style="color:#cd0000">red</span>`))
+}
+
+func TestHTML_SelectSwapsColours(t *testing.T) {
+	line := text.LineFromFragments(
+		*text.NewFragment("x").AddAtom(style.AtmSelect),
+	)
+
+	output := NewHTML(*styler.NewDefaultSpec()).
+		Theme(theme.Default()).
+		Render([]text.Line{*line}, winsize.New(1, 5))
+
+	assert.True(t, strings.Contains(output, `<span style="color:#000000;background:#e5e5e5">x</span>`))
+}
+
+func TestSVG_Render(t *testing.T) {
+	line := text.LineFromFragments(
+		*text.NewFragment("ab"),
+		*text.NewFragment("山").SetPaint(style.Paint{Bg: style.Ansi(style.Blue)}),
+	)
+
+	output := NewSVG(*styler.NewDefaultSpec()).
+		Theme(theme.Default()).
+		Render([]text.Line{*line, *line}, winsize.New(1, 10))
+
+	assert.True(t, strings.HasPrefix(output, `<svg xmlns="http://www.w3.org/2000/svg" width="90" height="18"`))
+	assert.True(t, strings.Contains(output, `<text x="0" y="14" textLength="18" lengthAdjust="spacingAndGlyphs">ab</text>`))
+	assert.True(t, strings.Contains(output, `<rect x="18" y="0" width="18" height="18" fill="#0000ee"/>`))
+	assert.Equal(t, 1, strings.Count(output, ">ab</text>"))
+}
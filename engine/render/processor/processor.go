@@ -4,6 +4,7 @@ import (
 	"strings"
 
 	"github.com/Rafael24595/go-reacterm-core/engine/helper"
+	"github.com/Rafael24595/go-reacterm-core/engine/helper/runes"
 	"github.com/Rafael24595/go-reacterm-core/engine/model/winsize"
 	"github.com/Rafael24595/go-reacterm-core/engine/render"
 	"github.com/Rafael24595/go-reacterm-core/engine/render/marker"
@@ -15,20 +16,15 @@ func WithPadding(
 	inner render.RawProcessor,
 ) render.Processor {
 	return func(lines []text.Line, size winsize.Winsize) string {
-		r := transform(size)
-
-		rows := min(r.Rows, size.Rows)
-		cols := min(r.Cols, size.Cols)
-		newSize := winsize.New(rows, cols)
+		newSize := paddingSize(transform, size)
+		rows := newSize.Rows
 
 		content := inner(lines, newSize)
 		content = normalize(content, rows)
 
-		diffRows := size.Rows.Sub(rows)
-		diffCols := size.Cols.Sub(cols)
-
-		topPadding := diffRows / 2
-		leftPadding := diffCols / 2
+		origin := paddingOrigin(size, newSize)
+		topPadding := origin.Rows
+		leftPadding := origin.Cols
 
 		buffer := make([]string, size.Rows)
 		for i := range size.Rows {
@@ -38,7 +34,7 @@ func WithPadding(
 		index := topPadding
 		for _, line := range content {
 			fixed := helper.Right(marker.DefaultPaddingText, leftPadding)
-			buffer[index] = helper.Right(fixed+line, size.Cols)
+			buffer[index] = padRight(fixed+line, size.Cols)
 			index += 1
 		}
 
@@ -46,6 +42,38 @@ func WithPadding(
 	}
 }
 
+func PaddingOrigin(transform func(winsize.Winsize) winsize.Winsize) render.Origin {
+	return func(size winsize.Winsize) winsize.Winsize {
+		return paddingOrigin(size, paddingSize(transform, size))
+	}
+}
+
+func paddingSize(transform func(winsize.Winsize) winsize.Winsize, size winsize.Winsize) winsize.Winsize {
+	r := transform(size)
+
+	rows := min(r.Rows, size.Rows)
+	cols := min(r.Cols, size.Cols)
+
+	return winsize.New(rows, cols)
+}
+
+func paddingOrigin(size, inner winsize.Winsize) winsize.Winsize {
+	diffRows := size.Rows.Sub(inner.Rows)
+	diffCols := size.Cols.Sub(inner.Cols)
+
+	return winsize.New(diffRows/2, diffCols/2)
+}
+
+// padRight measures only the visible runes, so the colour and attribute
+// sequences emitted by the inner processor don't shorten the margin.
+func padRight(line string, width winsize.Cols) string {
+	size := runes.MeasureVisible(line)
+	if size >= width {
+		return line
+	}
+	return line + strings.Repeat(marker.DefaultPaddingText, int(width-size))
+}
+
 func normalize(lines []string, rows winsize.Rows) []string {
 	buffer := make([]string, rows)
 	copy(buffer, lines)
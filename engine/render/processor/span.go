@@ -0,0 +1,82 @@
+package processor
+
+import (
+	"github.com/Rafael24595/go-reacterm-core/engine/model/winsize"
+	"github.com/Rafael24595/go-reacterm-core/engine/render/style"
+	"github.com/Rafael24595/go-reacterm-core/engine/render/styler"
+	"github.com/Rafael24595/go-reacterm-core/engine/render/text"
+	"github.com/Rafael24595/go-reacterm-core/engine/render/theme"
+)
+
+// group holds the styles shared by consecutive fragments, which are
+// rendered together to keep escape sequences to a minimum.
+type group struct {
+	atom  style.Atom
+	paint style.Paint
+	link  string
+}
+
+// span is a run of fragments sharing a group, with their specs applied.
+type span struct {
+	text  string
+	group group
+}
+
+func resolveTheme(pinned *theme.Theme) *theme.Theme {
+	if pinned != nil {
+		return pinned
+	}
+	return theme.Current()
+}
+
+func collectSpans(spec styler.Spec, line text.Line, size winsize.Winsize) []span {
+	spans := make([]span, 0)
+
+	fragments := ""
+	current := group{
+		atom:  style.AtmNone,
+		paint: line.Paint,
+	}
+
+	lineSize := winsize.New(
+		size.Rows,
+		size.Cols,
+	)
+
+	for _, f := range line.Text {
+		applied := spec.Apply(f.Spec, lineSize, f.Text, f.Size())
+
+		fragSize := text.FragmentMeasure(size.Cols, f)
+		lineSize.Cols = lineSize.Cols.Sub(fragSize)
+
+		next := group{
+			atom:  f.Atom,
+			paint: f.Paint.Inherit(line.Paint),
+			link:  f.Link,
+		}
+
+		if current != next && len(fragments) != 0 {
+			spans = append(spans, span{text: fragments, group: current})
+
+			fragments = applied
+			current = next
+
+			continue
+		}
+
+		fragments += applied
+		current = next
+	}
+
+	if len(fragments) != 0 {
+		spans = append(spans, span{text: fragments, group: current})
+	}
+
+	return spans
+}
+
+// layoutSpans applies the line spec around the spans of a line, so the
+// padding it adds can be styled without cutting through markup.
+func layoutSpans(spec styler.Spec, line text.Line, size winsize.Winsize) []span {
+	return collectSpans(spec, spec.Materialize(line, size), size)
+}
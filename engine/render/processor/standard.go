@@ -7,33 +7,60 @@ import (
 	"github.com/Rafael24595/go-reacterm-core/engine/render/style"
 	"github.com/Rafael24595/go-reacterm-core/engine/render/styler"
 	"github.com/Rafael24595/go-reacterm-core/engine/render/text"
+	"github.com/Rafael24595/go-reacterm-core/engine/render/theme"
 )
 
 type Standard struct {
-	atom styler.Atom
-	spec styler.Spec
+	atom  styler.Atom
+	spec  styler.Spec
+	color styler.Color
+	link  styler.Link
+	theme *theme.Theme
 }
 
 func New(atom styler.Atom, spec styler.Spec) Standard {
 	return Standard{
-		atom: atom,
-		spec: spec,
+		atom:  atom,
+		spec:  spec,
+		color: *styler.NewDefaultColor(),
+		link:  *styler.NewDefaultLink(),
+		theme: nil,
 	}
 }
 
+func (r Standard) Color(color styler.Color) Standard {
+	r.color = color
+	return r
+}
+
+func (r Standard) Link(link styler.Link) Standard {
+	r.link = link
+	return r
+}
+
+// Theme pins the processor to a theme, nil follows theme.Current.
+func (r Standard) Theme(theme *theme.Theme) Standard {
+	r.theme = theme
+	return r
+}
+
 func (r Standard) Render(lines []text.Line, size winsize.Winsize) []string {
 	buffer := make([]string, len(lines))
+	active := resolveTheme(r.theme)
 
 	for i, line := range lines {
+		line = active.ResolveLine(line)
 		measure := text.FragmentMeasure(size.Cols, line.Text...)
 		styled := r.renderLineFragments(line, size)
 
-		buffer[i] = r.spec.Apply(
+		spec := r.spec.Apply(
 			line.Spec,
 			size,
 			styled,
 			measure,
 		)
+
+		buffer[i] = r.color.Apply(spec, line.Paint, style.PaintNone())
 	}
 
 	return buffer
@@ -42,39 +69,15 @@ func (r Standard) Render(lines []text.Line, size winsize.Winsize) []string {
 func (r Standard) renderLineFragments(line text.Line, size winsize.Winsize) string {
 	var buffer strings.Builder
 
-	fragments := ""
-	atomStyles := style.AtmNone
-
-	lineSize := winsize.New(
-		size.Rows,
-		size.Cols,
-	)
-
-	for _, f := range line.Text {
-		spec := r.spec.Apply(f.Spec, lineSize, f.Text, f.Size())
-
-		fragSize := text.FragmentMeasure(size.Cols, f)
-		lineSize.Cols = lineSize.Cols.Sub(fragSize)
-
-		if atomStyles != f.Atom && len(fragments) != 0 {
-			atom := r.atom.Apply(fragments, atomStyles)
-			buffer.WriteString(atom)
-
-			fragments = spec
-			atomStyles = f.Atom
-
-			continue
-		}
-
-		fragments += spec
-		atomStyles = f.Atom
-	}
-
-	if len(fragments) != 0 {
-		atom := r.atom.Apply(fragments, atomStyles)
-		buffer.WriteString(atom)
+	for _, span := range collectSpans(r.spec, line, size) {
+		buffer.WriteString(r.renderGroup(span.text, span.group, line.Paint))
 	}
 
 	return buffer.String()
 }
 
+func (r Standard) renderGroup(fragments string, group group, parent style.Paint) string {
+	styled := r.atom.Apply(fragments, group.atom)
+	painted := r.color.Apply(styled, group.paint, parent)
+	return r.link.Apply(painted, group.link)
+}
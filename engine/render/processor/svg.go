@@ -0,0 +1,134 @@
+package processor
+
+import (
+	"fmt"
+	"html"
+	"strings"
+
+	"github.com/Rafael24595/go-reacterm-core/engine/helper/runes"
+	"github.com/Rafael24595/go-reacterm-core/engine/model/winsize"
+	"github.com/Rafael24595/go-reacterm-core/engine/render/styler"
+	"github.com/Rafael24595/go-reacterm-core/engine/render/text"
+	"github.com/Rafael24595/go-reacterm-core/engine/render/theme"
+)
+
+// Cell geometry in user units. Every span is stretched to the columns it
+// covers, so the grid holds whatever font the viewer falls back to.
+const (
+	svgCellWidth  = 9
+	svgCellHeight = 18
+	svgBaseline   = 14
+	svgFontSize   = 15
+)
+
+// SVG renders a frame as a standalone image, one text element per span
+// laid over the cells it covers.
+type SVG struct {
+	atom  styler.Atom
+	spec  styler.Spec
+	theme *theme.Theme
+	title string
+}
+
+func NewSVG(spec styler.Spec) SVG {
+	return SVG{
+		atom:  *styler.NewDefaultAtom(),
+		spec:  spec,
+		theme: nil,
+		title: defaultMarkupTitle,
+	}
+}
+
+// Theme pins the processor to a theme, nil follows theme.Current.
+func (r SVG) Theme(theme *theme.Theme) SVG {
+	r.theme = theme
+	return r
+}
+
+func (r SVG) Title(title string) SVG {
+	r.title = title
+	return r
+}
+
+func (r SVG) Render(lines []text.Line, size winsize.Winsize) string {
+	var buffer strings.Builder
+
+	width := int(size.Cols) * svgCellWidth
+	height := int(size.Rows) * svgCellHeight
+
+	fmt.Fprintf(&buffer,
+		"<svg xmlns=\"http://www.w3.org/2000/svg\" width=\"%d\" height=\"%d\" viewBox=\"0 0 %d %d\" ",
+		width, height, width, height,
+	)
+	fmt.Fprintf(&buffer,
+		"font-family=\"monospace\" font-size=\"%d\" fill=\"%s\" xml:space=\"preserve\">\n",
+		svgFontSize, cssColor(markupForeground),
+	)
+	buffer.WriteString("<title>" + html.EscapeString(r.title) + "</title>\n")
+	fmt.Fprintf(&buffer,
+		"<rect width=\"100%%\" height=\"100%%\" fill=\"%s\"/>\n",
+		cssColor(markupBackground),
+	)
+
+	active := resolveTheme(r.theme)
+	for row, line := range lines {
+		if row >= int(size.Rows) {
+			break
+		}
+
+		line = active.ResolveLine(line)
+
+		col := 0
+		for _, span := range layoutSpans(r.spec, line, size) {
+			content := r.atom.Apply(span.text, span.group.atom)
+			cols := int(runes.Measure(content))
+
+			buffer.WriteString(r.renderSpan(content, span.group, row, col, cols))
+			col += cols
+		}
+	}
+
+	buffer.WriteString("</svg>\n")
+
+	return buffer.String()
+}
+
+func (r SVG) renderSpan(content string, group group, row, col, cols int) string {
+	if cols == 0 {
+		return ""
+	}
+
+	var buffer strings.Builder
+
+	x := col * svgCellWidth
+	y := row * svgCellHeight
+
+	if bg := markupPaint(group).Bg; !bg.IsNone() {
+		fmt.Fprintf(&buffer,
+			"<rect x=\"%d\" y=\"%d\" width=\"%d\" height=\"%d\" fill=\"%s\"/>\n",
+			x, y, cols*svgCellWidth, svgCellHeight, cssColor(bg),
+		)
+	}
+
+	if strings.TrimSpace(content) == "" {
+		return buffer.String()
+	}
+
+	style := ""
+	if declarations := markupDeclarations(group, "fill"); len(declarations) != 0 {
+		style = " style=\"" + strings.Join(declarations, ";") + "\""
+	}
+
+	element := fmt.Sprintf(
+		"<text x=\"%d\" y=\"%d\" textLength=\"%d\" lengthAdjust=\"spacingAndGlyphs\"%s>%s</text>",
+		x, y+svgBaseline, cols*svgCellWidth, style, html.EscapeString(content),
+	)
+
+	if group.link != "" {
+		element = "<a href=\"" + html.EscapeString(group.link) + "\">" + element + "</a>"
+	}
+
+	buffer.WriteString(element + "\n")
+
+	return buffer.String()
+}
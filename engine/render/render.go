@@ -7,23 +7,41 @@ import (
 
 type Processor func([]text.Line, winsize.Winsize) string
 type RawProcessor func([]text.Line, winsize.Winsize) []string
+type Origin func(winsize.Winsize) winsize.Winsize
+
+func ZeroOrigin(_ winsize.Winsize) winsize.Winsize {
+	return winsize.Winsize{}
+}
 
 type Render struct {
 	Processor Processor
+	Origin    Origin
 }
 
 type RenderBuilder struct {
 	render Processor
+	origin Origin
 }
 
 func NewBuilder(processor Processor) *RenderBuilder {
 	return &RenderBuilder{
 		render: processor,
+		origin: ZeroOrigin,
+	}
+}
+
+func (b *RenderBuilder) Origin(origin Origin) *RenderBuilder {
+	if origin == nil {
+		return b
 	}
+
+	b.origin = origin
+	return b
 }
 
 func (b *RenderBuilder) ToRender() Render {
 	return Render{
 		Processor: b.render,
+		Origin:    b.origin,
 	}
 }
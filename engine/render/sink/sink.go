@@ -84,5 +84,26 @@ func ApplySinks(line *text.Line, cols winsize.Cols) *text.Line {
 		}
 		line = t(k, line, cols)
 	}
+	return sinkLinePaint(line)
+}
+
+// sinkLinePaint moves the line colours and role down to its fragments,
+// so they survive once the fragments are merged into a foreign line.
+func sinkLinePaint(line *text.Line) *text.Line {
+	if line.Paint.IsNone() && line.Role.IsNone() {
+		return line
+	}
+
+	frags := make([]text.Fragment, len(line.Text))
+	for i, f := range line.Text {
+		f.Paint = f.Paint.Inherit(line.Paint)
+		f.Role = f.Role.Or(line.Role)
+		frags[i] = f
+	}
+
+	line.Text = frags
+	line.Paint = style.PaintNone()
+	line.Role = style.RoleNone
+
 	return line
 }
@@ -28,3 +28,32 @@ func TestApplySinks_PaddingLeft(t *testing.T) {
 	assert.True(t, firstFrag.Spec.Kind().HasAny(style.SpcKindPaddingLeft))
 	assert.Equal(t, 5, commons.Mapd[winsize.Cols](firstFrag.Spec.Args()[style.KeyPaddingLeftSize], 0))
 }
+
+func TestApplySinks_Paint(t *testing.T) {
+	bg := style.Ansi(style.Blue)
+	fg := style.Ansi(style.Red)
+
+	line := text.LineFromFragments(
+		*text.NewFragment("a").SetFg(fg),
+	).SetBg(bg).AddSpec(style.SpecPaddingLeft(5, "-"))
+
+	ApplySinks(line, 80)
+
+	assert.True(t, line.Paint.IsNone())
+	assert.Len(t, 2, line.Text)
+	assert.Equal(t, style.Paint{Bg: bg}, line.Text[0].Paint)
+	assert.Equal(t, style.Paint{Fg: fg, Bg: bg}, line.Text[1].Paint)
+}
+
+func TestApplySinks_Role(t *testing.T) {
+	line := text.LineFromFragments(
+		*text.NewFragment("a"),
+		*text.NewFragment("b").SetRole(style.RoleError),
+	).SetRole(style.RoleHeader)
+
+	ApplySinks(line, 80)
+
+	assert.True(t, line.Role.IsNone())
+	assert.Equal(t, style.RoleHeader, line.Text[0].Role)
+	assert.Equal(t, style.RoleError, line.Text[1].Role)
+}
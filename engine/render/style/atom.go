@@ -1,6 +1,6 @@
 package style
 
-type Atom uint8
+type Atom uint32
 
 const (
 	AtmNone Atom = 0
@@ -11,6 +11,14 @@ const (
 	AtmFocus
 	AtmWrap
 	AtmBreak
+	AtmItalic
+	AtmUnderline
+	AtmCurlyUnderline
+	AtmDoubleUnderline
+	AtmDim
+	AtmStrike
+	AtmBlink
+	AtmOverline
 )
 
 func MergeAtom(styles ...Atom) Atom {
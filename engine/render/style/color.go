@@ -0,0 +1,121 @@
+package style
+
+import (
+	"strconv"
+	"strings"
+)
+
+type ColorKind uint8
+
+const (
+	ColorKindNone ColorKind = iota
+	ColorKindAnsi
+	ColorKindIndexed
+	ColorKindRGB
+)
+
+type AnsiColor uint8
+
+const (
+	Black AnsiColor = iota
+	Red
+	Green
+	Yellow
+	Blue
+	Magenta
+	Cyan
+	White
+	BrightBlack
+	BrightRed
+	BrightGreen
+	BrightYellow
+	BrightBlue
+	BrightMagenta
+	BrightCyan
+	BrightWhite
+)
+
+const ansiColors = 16
+
+type Color struct {
+	Kind  ColorKind
+	Value uint32
+}
+
+func ColorNone() Color {
+	return Color{}
+}
+
+func Ansi(color AnsiColor) Color {
+	return Color{
+		Kind:  ColorKindAnsi,
+		Value: uint32(color % ansiColors),
+	}
+}
+
+func Indexed(index uint8) Color {
+	return Color{
+		Kind:  ColorKindIndexed,
+		Value: uint32(index),
+	}
+}
+
+func RGB(r, g, b uint8) Color {
+	return Color{
+		Kind:  ColorKindRGB,
+		Value: uint32(r)<<16 | uint32(g)<<8 | uint32(b),
+	}
+}
+
+func Hex(hex string) (Color, bool) {
+	hex = strings.TrimPrefix(hex, "#")
+	if len(hex) != 6 {
+		return ColorNone(), false
+	}
+
+	value, err := strconv.ParseUint(hex, 16, 32)
+	if err != nil {
+		return ColorNone(), false
+	}
+
+	return Color{
+		Kind:  ColorKindRGB,
+		Value: uint32(value),
+	}, true
+}
+
+func (c Color) IsNone() bool {
+	return c.Kind == ColorKindNone
+}
+
+func (c Color) Or(fallback Color) Color {
+	if c.IsNone() {
+		return fallback
+	}
+	return c
+}
+
+func (c Color) Components() (uint8, uint8, uint8) {
+	return uint8(c.Value >> 16), uint8(c.Value >> 8), uint8(c.Value)
+}
+
+type Paint struct {
+	Fg Color
+	Bg Color
+}
+
+func PaintNone() Paint {
+	return Paint{}
+}
+
+func (p Paint) IsNone() bool {
+	return p.Fg.IsNone() && p.Bg.IsNone()
+}
+
+// Inherit fills the channels left unset with the ones from parent.
+func (p Paint) Inherit(parent Paint) Paint {
+	return Paint{
+		Fg: p.Fg.Or(parent.Fg),
+		Bg: p.Bg.Or(parent.Bg),
+	}
+}
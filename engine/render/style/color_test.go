@@ -0,0 +1,33 @@
+package style
+
+import (
+	"testing"
+
+	assert "github.com/Rafael24595/go-assert/assert/test"
+)
+
+func TestHex(t *testing.T) {
+	color, ok := Hex("#0a141e")
+
+	assert.True(t, ok)
+	assert.Equal(t, RGB(10, 20, 30), color)
+
+	_, ok = Hex("#fff")
+	assert.False(t, ok)
+}
+
+func TestPaint_Inherit(t *testing.T) {
+	parent := Paint{Fg: Ansi(White), Bg: Ansi(Blue)}
+	paint := Paint{Fg: Indexed(208)}
+
+	assert.Equal(t, Paint{Fg: Indexed(208), Bg: Ansi(Blue)}, paint.Inherit(parent))
+	assert.Equal(t, parent, PaintNone().Inherit(parent))
+}
+
+func TestColor_ToRGB(t *testing.T) {
+	assert.Equal(t, RGB(205, 0, 0), Ansi(Red).ToRGB())
+	assert.Equal(t, RGB(255, 135, 0), Indexed(208).ToRGB())
+	assert.Equal(t, RGB(8, 8, 8), Indexed(232).ToRGB())
+	assert.Equal(t, RGB(1, 2, 3), RGB(1, 2, 3).ToRGB())
+	assert.Equal(t, ColorNone(), ColorNone().ToRGB())
+}
@@ -0,0 +1,37 @@
+package style
+
+// ansiPalette is the xterm rendition of the 16 base colours.
+var ansiPalette = [ansiColors]uint32{
+	0x000000, 0xcd0000, 0x00cd00, 0xcdcd00,
+	0x0000ee, 0xcd00cd, 0x00cdcd, 0xe5e5e5,
+	0x7f7f7f, 0xff0000, 0x00ff00, 0xffff00,
+	0x5c5cff, 0xff00ff, 0x00ffff, 0xffffff,
+}
+
+var cubeLevels = [6]uint32{0, 95, 135, 175, 215, 255}
+
+// ToRGB resolves palette colours to the values an xterm would show.
+// An unset colour stays unset.
+func (c Color) ToRGB() Color {
+	switch c.Kind {
+	case ColorKindAnsi:
+		return Color{Kind: ColorKindRGB, Value: ansiPalette[c.Value%ansiColors]}
+	case ColorKindIndexed:
+		return Color{Kind: ColorKindRGB, Value: indexedRGB(c.Value)}
+	}
+	return c
+}
+
+func indexedRGB(index uint32) uint32 {
+	switch {
+	case index < ansiColors:
+		return ansiPalette[index]
+	case index < 232:
+		index -= ansiColors
+		r, g, b := cubeLevels[index/36], cubeLevels[index/6%6], cubeLevels[index%6]
+		return r<<16 | g<<8 | b
+	default:
+		level := 8 + (index-232)*10
+		return level<<16 | level<<8 | level
+	}
+}
@@ -0,0 +1,31 @@
+package style
+
+type Role string
+
+const (
+	RoleNone         Role = ""
+	RoleFocus        Role = "focus"
+	RoleSelection    Role = "selection"
+	RoleHeader       Role = "header"
+	RoleFooter       Role = "footer"
+	RoleMuted        Role = "muted"
+	RoleError        Role = "error"
+	RoleBorder       Role = "border"
+	RoleGutter       Role = "gutter"
+	RoleGutterActive Role = "gutter.active"
+	RoleEmphasis     Role = "emphasis"
+	RoleHelp         Role = "help"
+	RoleScrollbar    Role = "scrollbar"
+	RoleScrollThumb  Role = "scrollbar.thumb"
+)
+
+func (r Role) IsNone() bool {
+	return r == RoleNone
+}
+
+func (r Role) Or(fallback Role) Role {
+	if r.IsNone() {
+		return fallback
+	}
+	return r
+}
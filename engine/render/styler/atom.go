@@ -26,6 +26,30 @@ var Atoms = dict.NewInmutableLinkedMap(
 	pa(style.AtmSelect, func(text string) string {
 		return text
 	}),
+	pa(style.AtmDim, func(text string) string {
+		return text
+	}),
+	pa(style.AtmItalic, func(text string) string {
+		return text
+	}),
+	pa(style.AtmCurlyUnderline, func(text string) string {
+		return text
+	}),
+	pa(style.AtmDoubleUnderline, func(text string) string {
+		return text
+	}),
+	pa(style.AtmUnderline, func(text string) string {
+		return text
+	}),
+	pa(style.AtmStrike, func(text string) string {
+		return text
+	}),
+	pa(style.AtmBlink, func(text string) string {
+		return text
+	}),
+	pa(style.AtmOverline, func(text string) string {
+		return text
+	}),
 )
 
 type Atom struct {
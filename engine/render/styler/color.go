@@ -0,0 +1,28 @@
+package styler
+
+import "github.com/Rafael24595/go-reacterm-core/engine/render/style"
+
+// ColorStyler paints text and then hands the cursor back to parent, so
+// nested colours never reset their enclosing ones.
+type ColorStyler func(text string, paint, parent style.Paint) string
+
+type Color struct {
+	styler ColorStyler
+}
+
+func NewColor(styler ColorStyler) *Color {
+	return &Color{
+		styler: styler,
+	}
+}
+
+func NewDefaultColor() *Color {
+	return &Color{}
+}
+
+func (c *Color) Apply(text string, paint, parent style.Paint) string {
+	if c.styler == nil || paint == parent {
+		return text
+	}
+	return c.styler(text, paint, parent)
+}
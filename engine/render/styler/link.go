@@ -0,0 +1,25 @@
+package styler
+
+// LinkStyler turns text into a hyperlink to uri.
+type LinkStyler func(text, uri string) string
+
+type Link struct {
+	styler LinkStyler
+}
+
+func NewLink(styler LinkStyler) *Link {
+	return &Link{
+		styler: styler,
+	}
+}
+
+func NewDefaultLink() *Link {
+	return &Link{}
+}
+
+func (l *Link) Apply(text, uri string) string {
+	if l.styler == nil || uri == "" {
+		return text
+	}
+	return l.styler(text, uri)
+}
@@ -0,0 +1,76 @@
+package styler
+
+import (
+	"strings"
+
+	"github.com/Rafael24595/go-reacterm-core/engine/model/winsize"
+	"github.com/Rafael24595/go-reacterm-core/engine/render/style"
+	"github.com/Rafael24595/go-reacterm-core/engine/render/text"
+)
+
+// placeholder stands in for the content of a line while its spec is
+// laid out, so the padding around it can be told apart from the text.
+const placeholder = "\ue000"
+
+// rewrites holds the spec kinds that change the content itself rather
+// than adding around it, so they cannot be laid out on the placeholder.
+var rewrites = []style.SpecKind{
+	style.SpcKindFill,
+	style.SpcKindTrimLeft,
+	style.SpcKindTrimRight,
+}
+
+// Materialize bakes the specs of a line into its text, leaving plain
+// fragments that measure exactly what they print. Padding from the line
+// spec becomes fragments of its own; specs that rewrite the content
+// itself, like fill or trim, flatten the line into a single fragment.
+func (a *Spec) Materialize(line text.Line, size winsize.Winsize) text.Line {
+	frags := make([]text.Fragment, 0, len(line.Text)+2)
+
+	lineSize := winsize.New(
+		size.Rows,
+		size.Cols,
+	)
+
+	for _, f := range line.Text {
+		frag := *f.Clone()
+		frag.Text = a.Apply(f.Spec, lineSize, f.Text, f.Size())
+		frag.Spec = style.SpecEmpty()
+
+		lineSize.Cols = lineSize.Cols.Sub(text.FragmentMeasure(size.Cols, f))
+
+		frags = append(frags, frag)
+	}
+
+	measure := text.FragmentMeasure(size.Cols, line.Text...)
+
+	result := line
+	result.Spec = style.SpecEmpty()
+
+	layout := a.Apply(line.Spec, size, placeholder, measure)
+	if line.Spec.Kind().HasAny(rewrites...) || strings.Count(layout, placeholder) != 1 {
+		var plain strings.Builder
+		for _, f := range frags {
+			plain.WriteString(f.Text)
+		}
+
+		layout = a.Apply(line.Spec, size, plain.String(), measure)
+		result.Text = []text.Fragment{*text.NewFragment(layout)}
+		return result
+	}
+
+	before, after, _ := strings.Cut(layout, placeholder)
+
+	result.Text = make([]text.Fragment, 0, len(frags)+2)
+	if before != "" {
+		result.Text = append(result.Text, *text.NewFragment(before))
+	}
+
+	result.Text = append(result.Text, frags...)
+
+	if after != "" {
+		result.Text = append(result.Text, *text.NewFragment(after))
+	}
+
+	return result
+}
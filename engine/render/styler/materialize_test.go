@@ -0,0 +1,54 @@
+package styler
+
+import (
+	"testing"
+
+	assert "github.com/Rafael24595/go-assert/assert/test"
+
+	"github.com/Rafael24595/go-reacterm-core/engine/model/winsize"
+	"github.com/Rafael24595/go-reacterm-core/engine/render/style"
+	"github.com/Rafael24595/go-reacterm-core/engine/render/text"
+)
+
+func TestMaterialize_LinePadding(t *testing.T) {
+	line := text.LineFromFragments(
+		*text.NewFragment("ab").AddAtom(style.AtmBold),
+		*text.NewFragment("c"),
+	)
+	line.Spec = style.SpecPaddingCenter(7)
+
+	got := NewDefaultSpec().Materialize(*line, winsize.New(1, 10))
+
+	assert.Len(t, 4, got.Text)
+	assert.Equal(t, "  ", got.Text[0].Text)
+	assert.Equal(t, "ab", got.Text[1].Text)
+	assert.True(t, got.Text[1].Atom.HasAny(style.AtmBold))
+	assert.Equal(t, "c", got.Text[2].Text)
+	assert.Equal(t, "  ", got.Text[3].Text)
+	assert.Equal(t, style.SpcKindNone, got.Spec.Kind())
+}
+
+func TestMaterialize_FragmentSpec(t *testing.T) {
+	line := text.LineFromFragments(
+		*text.NewFragment("ab").AddSpec(style.SpecPaddingLeft(4)),
+	)
+
+	got := NewDefaultSpec().Materialize(*line, winsize.New(1, 10))
+
+	assert.Len(t, 1, got.Text)
+	assert.Equal(t, "  ab", got.Text[0].Text)
+	assert.Equal(t, style.SpcKindNone, got.Text[0].Spec.Kind())
+}
+
+func TestMaterialize_FlattensRewrittenContent(t *testing.T) {
+	line := text.LineFromFragments(
+		*text.NewFragment("abc"),
+		*text.NewFragment("def"),
+	)
+	line.Spec = style.SpecTrimRight(4)
+
+	got := NewDefaultSpec().Materialize(*line, winsize.New(1, 10))
+
+	assert.Len(t, 1, got.Text)
+	assert.Equal(t, 4, int(text.FragmentMeasure(10, got.Text...)))
+}
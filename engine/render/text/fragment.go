@@ -2,21 +2,27 @@ package text
 
 import (
 	"github.com/Rafael24595/go-reacterm-core/engine/helper/runes"
+	"github.com/Rafael24595/go-reacterm-core/engine/model/hit"
 	"github.com/Rafael24595/go-reacterm-core/engine/model/winsize"
 	"github.com/Rafael24595/go-reacterm-core/engine/render/style"
 )
 
 type Fragment struct {
-	Text string
-	Atom style.Atom
-	Spec style.Spec
+	Text  string
+	Atom  style.Atom
+	Spec  style.Spec
+	Paint style.Paint
+	Role  style.Role
+	Link  string
+	Hit   *hit.Target
 }
 
 func NewFragment(text string) *Fragment {
 	return &Fragment{
-		Text: text,
-		Atom: style.AtmNone,
-		Spec: style.SpecEmpty(),
+		Text:  text,
+		Atom:  style.AtmNone,
+		Spec:  style.SpecEmpty(),
+		Paint: style.PaintNone(),
 	}
 }
 
@@ -35,6 +41,10 @@ func FragmentFromMeta(other *Fragment) *Fragment {
 func (f *Fragment) CopyMeta(other *Fragment) *Fragment {
 	f.Atom = other.Atom
 	f.Spec = other.Spec
+	f.Paint = other.Paint
+	f.Role = other.Role
+	f.Link = other.Link
+	f.Hit = other.Hit
 	return f
 }
 
@@ -60,6 +70,40 @@ func (f *Fragment) CutSpec(styles style.SpecKind) *Fragment {
 	return f
 }
 
+func (f *Fragment) SetFg(color style.Color) *Fragment {
+	f.Paint.Fg = color
+	return f
+}
+
+func (f *Fragment) SetBg(color style.Color) *Fragment {
+	f.Paint.Bg = color
+	return f
+}
+
+func (f *Fragment) SetPaint(paint style.Paint) *Fragment {
+	f.Paint = paint
+	return f
+}
+
+func (f *Fragment) SetRole(role style.Role) *Fragment {
+	f.Role = role
+	return f
+}
+
+// SetLink turns the fragment into a hyperlink to uri, an empty uri
+// removes it.
+func (f *Fragment) SetLink(uri string) *Fragment {
+	f.Link = uri
+	return f
+}
+
+// SetHit makes the cells of the fragment resolve to target, over the
+// target of its line.
+func (f *Fragment) SetHit(target *hit.Target) *Fragment {
+	f.Hit = target
+	return f
+}
+
 func (f *Fragment) Size() winsize.Cols {
 	return runes.Measure(f.Text)
 }
@@ -85,10 +129,17 @@ func FragmentMeasure(cols winsize.Cols, frags ...Fragment) winsize.Cols {
 func IsZeroFragment(frag Fragment) bool {
 	return frag.Text == "" &&
 		frag.Atom == style.AtmNone &&
-		frag.Spec.Kind() == style.SpcKindNone
+		frag.Spec.Kind() == style.SpcKindNone &&
+		frag.Paint.IsNone() &&
+		frag.Role.IsNone() &&
+		frag.Link == ""
 }
 
 func IsStructuralFragment(frag Fragment) bool {
-	hasStyles := frag.Atom != style.AtmNone || frag.Spec.Kind() != style.SpcKindNone
+	hasStyles := frag.Atom != style.AtmNone ||
+		frag.Spec.Kind() != style.SpcKindNone ||
+		!frag.Paint.IsNone() ||
+		!frag.Role.IsNone() ||
+		frag.Link != ""
 	return frag.Text == "" && hasStyles
 }
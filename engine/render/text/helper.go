@@ -1,6 +1,9 @@
 package text
 
 import (
+	"strings"
+
+	"github.com/Rafael24595/go-reacterm-core/engine/helper/runes"
 	"github.com/Rafael24595/go-reacterm-core/engine/model/winsize"
 	"github.com/Rafael24595/go-reacterm-core/engine/render/style"
 )
@@ -36,6 +39,13 @@ func ApplyLineSpec(style style.Spec, lines ...Line) []Line {
 	return lines
 }
 
+func DefaultLineRole(role style.Role, lines ...Line) []Line {
+	for i := range lines {
+		lines[i].Role = lines[i].Role.Or(role)
+	}
+	return lines
+}
+
 func LinesHasAtom(atom style.Atom, lines ...Line) bool {
 	for _, line := range lines {
 		if FragsHasAtom(atom, line.Text...) {
@@ -70,3 +80,43 @@ func CloneLines(lines ...Line) []Line {
 	}
 	return clones
 }
+
+// SliceCells keeps the cells [from, to) of frags, which must hold no
+// specs. A wide cluster cut by either edge is replaced with blanks.
+func SliceCells(frags []Fragment, from, to winsize.Cols) []Fragment {
+	result := make([]Fragment, 0, len(frags))
+
+	position := winsize.Cols(0)
+	for _, f := range frags {
+		if position >= to {
+			break
+		}
+
+		var buffer strings.Builder
+		for _, cluster := range runes.Graphemes(f.Text) {
+			size := runes.Measure(cluster)
+			start, end := position, position+size
+			position = end
+
+			switch {
+			case end <= from || start >= to:
+				continue
+			case start >= from && end <= to:
+				buffer.WriteString(cluster)
+			default:
+				cells := min(end, to) - max(start, from)
+				buffer.WriteString(strings.Repeat(" ", int(cells)))
+			}
+		}
+
+		if buffer.Len() == 0 {
+			continue
+		}
+
+		frag := f
+		frag.Text = buffer.String()
+		result = append(result, frag)
+	}
+
+	return result
+}
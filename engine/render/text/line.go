@@ -3,6 +3,7 @@ package text
 import (
 	"strings"
 
+	"github.com/Rafael24595/go-reacterm-core/engine/model/hit"
 	"github.com/Rafael24595/go-reacterm-core/engine/model/winsize"
 	"github.com/Rafael24595/go-reacterm-core/engine/render/style"
 )
@@ -11,6 +12,9 @@ type Line struct {
 	Order uint16
 	Text  []Fragment
 	Spec  style.Spec
+	Paint style.Paint
+	Role  style.Role
+	Hit   *hit.Target
 }
 
 func NewLine(text string, styles ...style.Spec) *Line {
@@ -39,6 +43,9 @@ func LineFromFragments(frags ...Fragment) *Line {
 
 func (l *Line) CopyMeta(other *Line) *Line {
 	l.Order = other.Order
+	l.Hit = other.Hit
+	l.Paint = other.Paint.Inherit(l.Paint)
+	l.Role = other.Role.Or(l.Role)
 	l.AddSpec(other.Spec)
 	return l
 }
@@ -48,6 +55,67 @@ func (l *Line) SetOrder(order uint16) *Line {
 	return l
 }
 
+func (l *Line) SetHit(target *hit.Target) *Line {
+	l.Hit = target
+	return l
+}
+
+// SpreadHit hands the target of the line to every fragment without one,
+// so it survives when the fragments are joined to another line.
+func (l *Line) SpreadHit() *Line {
+	if l.Hit == nil {
+		return l
+	}
+
+	frags := make([]Fragment, len(l.Text))
+	for i, f := range l.Text {
+		if f.Hit == nil {
+			f.Hit = l.Hit
+		}
+		frags[i] = f
+	}
+
+	l.Text = frags
+	return l
+}
+
+// HitAt resolves the target under col. The line must be materialized,
+// so its fragments measure what they print.
+func (l *Line) HitAt(col winsize.Cols) *hit.Target {
+	cursor := winsize.Cols(0)
+	for _, f := range l.Text {
+		cursor += f.Size()
+		if col < cursor {
+			if f.Hit != nil {
+				return f.Hit
+			}
+			break
+		}
+	}
+
+	return l.Hit
+}
+
+func (l *Line) SetFg(color style.Color) *Line {
+	l.Paint.Fg = color
+	return l
+}
+
+func (l *Line) SetBg(color style.Color) *Line {
+	l.Paint.Bg = color
+	return l
+}
+
+func (l *Line) SetPaint(paint style.Paint) *Line {
+	l.Paint = paint
+	return l
+}
+
+func (l *Line) SetRole(role style.Role) *Line {
+	l.Role = role
+	return l
+}
+
 func (l *Line) UnshiftFragments(frags ...Fragment) *Line {
 	l.Text = append(frags, l.Text...)
 	return l
@@ -0,0 +1,77 @@
+package theme
+
+import "github.com/Rafael24595/go-reacterm-core/engine/render/style"
+
+const (
+	NameDefault      = "default"
+	NameDark         = "dark"
+	NameLight        = "light"
+	NameHighContrast = "high-contrast"
+)
+
+func fg(color style.AnsiColor) style.Paint {
+	return style.Paint{Fg: style.Ansi(color)}
+}
+
+func fgbg(fg, bg style.AnsiColor) style.Paint {
+	return style.Paint{Fg: style.Ansi(fg), Bg: style.Ansi(bg)}
+}
+
+// Default reproduces the monochrome look the widgets had before roles.
+func Default() *Theme {
+	return New(NameDefault).
+		SetLook(style.RoleFocus, NewLook(style.AtmSelect, style.PaintNone())).
+		SetLook(style.RoleSelection, NewLook(style.AtmSelect, style.PaintNone())).
+		SetLook(style.RoleEmphasis, NewLook(style.AtmBold, style.PaintNone()))
+}
+
+func Dark() *Theme {
+	return Default().Extend(NameDark).
+		SetLook(style.RoleFocus, NewLook(style.AtmBold, fgbg(style.BrightWhite, style.Blue))).
+		SetLook(style.RoleSelection, NewLook(style.AtmNone, fgbg(style.Black, style.Cyan))).
+		SetLook(style.RoleHeader, NewLook(style.AtmBold, fg(style.BrightCyan))).
+		SetLook(style.RoleFooter, NewLook(style.AtmNone, fg(style.BrightBlack))).
+		SetLook(style.RoleMuted, NewLook(style.AtmNone, fg(style.BrightBlack))).
+		SetLook(style.RoleError, NewLook(style.AtmBold, fg(style.BrightRed))).
+		SetLook(style.RoleBorder, NewLook(style.AtmNone, fg(style.BrightBlack))).
+		SetLook(style.RoleGutter, NewLook(style.AtmNone, fg(style.BrightBlack))).
+		SetLook(style.RoleGutterActive, NewLook(style.AtmNone, fg(style.BrightCyan))).
+		SetLook(style.RoleEmphasis, NewLook(style.AtmBold, fg(style.BrightWhite))).
+		SetLook(style.RoleHelp, NewLook(style.AtmNone, fg(style.BrightBlack))).
+		SetLook(style.RoleScrollbar, NewLook(style.AtmNone, fg(style.BrightBlack))).
+		SetLook(style.RoleScrollThumb, NewLook(style.AtmNone, fg(style.BrightCyan)))
+}
+
+func Light() *Theme {
+	return Default().Extend(NameLight).
+		SetLook(style.RoleFocus, NewLook(style.AtmBold, fgbg(style.White, style.Blue))).
+		SetLook(style.RoleSelection, NewLook(style.AtmNone, fgbg(style.Black, style.BrightCyan))).
+		SetLook(style.RoleHeader, NewLook(style.AtmBold, fg(style.Blue))).
+		SetLook(style.RoleFooter, NewLook(style.AtmNone, fg(style.BrightBlack))).
+		SetLook(style.RoleMuted, NewLook(style.AtmNone, fg(style.BrightBlack))).
+		SetLook(style.RoleError, NewLook(style.AtmBold, fg(style.Red))).
+		SetLook(style.RoleBorder, NewLook(style.AtmNone, fg(style.BrightBlack))).
+		SetLook(style.RoleGutter, NewLook(style.AtmNone, fg(style.BrightBlack))).
+		SetLook(style.RoleGutterActive, NewLook(style.AtmNone, fg(style.Blue))).
+		SetLook(style.RoleEmphasis, NewLook(style.AtmBold, fg(style.Black))).
+		SetLook(style.RoleHelp, NewLook(style.AtmNone, fg(style.BrightBlack))).
+		SetLook(style.RoleScrollbar, NewLook(style.AtmNone, fg(style.BrightBlack))).
+		SetLook(style.RoleScrollThumb, NewLook(style.AtmNone, fg(style.Blue)))
+}
+
+// HighContrast relies on attributes rather than hue, so it stays legible
+// on monochrome terminals and for users who cannot tell colours apart.
+func HighContrast() *Theme {
+	return Default().Extend(NameHighContrast).
+		SetLook(style.RoleFocus, NewLook(style.MergeAtom(style.AtmSelect, style.AtmBold), style.PaintNone())).
+		SetLook(style.RoleSelection, NewLook(style.MergeAtom(style.AtmSelect, style.AtmUnderline), style.PaintNone())).
+		SetLook(style.RoleHeader, NewLook(style.MergeAtom(style.AtmBold, style.AtmUnderline), fg(style.BrightWhite))).
+		SetLook(style.RoleFooter, NewLook(style.AtmBold, style.PaintNone())).
+		SetLook(style.RoleError, NewLook(style.MergeAtom(style.AtmBold, style.AtmUnderline), fg(style.BrightYellow))).
+		SetLook(style.RoleBorder, NewLook(style.AtmBold, style.PaintNone())).
+		SetLook(style.RoleGutterActive, NewLook(style.AtmBold, style.PaintNone())).
+		SetLook(style.RoleEmphasis, NewLook(style.MergeAtom(style.AtmBold, style.AtmUnderline), style.PaintNone())).
+		SetLook(style.RoleHelp, NewLook(style.AtmBold, style.PaintNone())).
+		SetLook(style.RoleScrollThumb, NewLook(style.AtmBold, style.PaintNone())).
+		SetGlyph(GlyphGutterLeft, "█", "#")
+}
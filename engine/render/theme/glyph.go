@@ -0,0 +1,50 @@
+package theme
+
+import (
+	"github.com/Rafael24595/go-reacterm-core/engine/render/marker"
+	"github.com/Rafael24595/go-reacterm-core/engine/terminal/capability"
+)
+
+type Glyph string
+
+const (
+	GlyphGutterLeft        Glyph = "gutter.left"
+	GlyphGutterMiddle      Glyph = "gutter.middle"
+	GlyphGutterRight       Glyph = "gutter.right"
+	GlyphPrompt            Glyph = "prompt"
+	GlyphScrollTrack       Glyph = "scroll.track"
+	GlyphScrollThumb       Glyph = "scroll.thumb"
+	GlyphDividerVertical   Glyph = "divider.vertical"
+	GlyphDividerHorizontal Glyph = "divider.horizontal"
+)
+
+type glyphSet struct {
+	unicode string
+	ascii   string
+}
+
+func (g glyphSet) text() string {
+	return capability.Glyph(g.unicode, g.ascii)
+}
+
+func defaultGlyph(glyph Glyph) string {
+	switch glyph {
+	case GlyphGutterLeft:
+		return marker.LeftGutterText()
+	case GlyphGutterMiddle:
+		return marker.MiddleGutterText()
+	case GlyphGutterRight:
+		return marker.RightGutterText()
+	case GlyphPrompt:
+		return marker.DefaultPromptText
+	case GlyphScrollTrack:
+		return marker.ScrollTrackText()
+	case GlyphScrollThumb:
+		return marker.ScrollThumbText()
+	case GlyphDividerVertical:
+		return marker.DividerVerticalText()
+	case GlyphDividerHorizontal:
+		return marker.DividerHorizontalText()
+	}
+	return ""
+}
@@ -0,0 +1,299 @@
+package theme
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"slices"
+	"strings"
+
+	"github.com/Rafael24595/go-reacterm-core/engine/commons/conf"
+	"github.com/Rafael24595/go-reacterm-core/engine/render/style"
+)
+
+const (
+	keyName   = "name"
+	keyBase   = "base"
+	keyRoles  = "roles"
+	keyGlyphs = "glyphs"
+
+	keyFg    = "fg"
+	keyBg    = "bg"
+	keyAtoms = "atoms"
+)
+
+var colorNames = map[string]style.AnsiColor{
+	"black":          style.Black,
+	"red":            style.Red,
+	"green":          style.Green,
+	"yellow":         style.Yellow,
+	"blue":           style.Blue,
+	"magenta":        style.Magenta,
+	"cyan":           style.Cyan,
+	"white":          style.White,
+	"bright_black":   style.BrightBlack,
+	"gray":           style.BrightBlack,
+	"grey":           style.BrightBlack,
+	"bright_red":     style.BrightRed,
+	"bright_green":   style.BrightGreen,
+	"bright_yellow":  style.BrightYellow,
+	"bright_blue":    style.BrightBlue,
+	"bright_magenta": style.BrightMagenta,
+	"bright_cyan":    style.BrightCyan,
+	"bright_white":   style.BrightWhite,
+}
+
+var atomNames = map[string]style.Atom{
+	"bold":             style.AtmBold,
+	"dim":              style.AtmDim,
+	"italic":           style.AtmItalic,
+	"underline":        style.AtmUnderline,
+	"curly_underline":  style.AtmCurlyUnderline,
+	"double_underline": style.AtmDoubleUnderline,
+	"strike":           style.AtmStrike,
+	"blink":            style.AtmBlink,
+	"overline":         style.AtmOverline,
+	"reverse":          style.AtmSelect,
+	"upper":            style.AtmUpper,
+	"lower":            style.AtmLower,
+}
+
+// Load reads a theme from a JSON or TOML file. The theme extends the
+// registered theme named by its "base" key, or the default theme.
+func Load(path string) (*Theme, error) {
+	values, err := conf.DecodeFile(path)
+	if err != nil {
+		return nil, err
+	}
+
+	return fromValues(values)
+}
+
+func Parse(data []byte, format conf.Format) (*Theme, error) {
+	values, err := conf.Decode(data, format)
+	if err != nil {
+		return nil, err
+	}
+
+	return fromValues(values)
+}
+
+func fromValues(values map[string]any) (*Theme, error) {
+	name, err := optionalString(values, keyName)
+	if err != nil {
+		return nil, err
+	}
+
+	baseName, err := optionalString(values, keyBase)
+	if err != nil {
+		return nil, err
+	}
+
+	if baseName == "" {
+		baseName = NameDefault
+	}
+
+	base, ok := Lookup(baseName)
+	if !ok {
+		return nil, fmt.Errorf("unknown base theme %q", baseName)
+	}
+
+	theme := base.Extend(name)
+
+	var errs []error
+
+	roles, err := optionalTable(values, keyRoles)
+	if err != nil {
+		errs = append(errs, err)
+	}
+
+	errs = append(errs, collectLooks(theme, "", roles)...)
+
+	glyphs, err := optionalTable(values, keyGlyphs)
+	if err != nil {
+		errs = append(errs, err)
+	}
+
+	for _, glyph := range sortedKeys(glyphs) {
+		variants, ok := conf.Strings(glyphs[glyph])
+		if !ok || len(variants) == 0 || len(variants) > 2 {
+			errs = append(errs, fmt.Errorf("glyph %q: expected a string or a [unicode, ascii] pair", glyph))
+			continue
+		}
+
+		ascii := variants[len(variants)-1]
+		theme.SetGlyph(Glyph(glyph), variants[0], ascii)
+	}
+
+	if err := errors.Join(errs...); err != nil {
+		return nil, err
+	}
+
+	return theme, nil
+}
+
+// collectLooks walks the role tables. Nested tables name nested roles,
+// so [roles.gutter.active] defines the "gutter.active" role.
+func collectLooks(theme *Theme, prefix string, roles map[string]any) []error {
+	var errs []error
+	for _, name := range sortedKeys(roles) {
+		role := name
+		if prefix != "" {
+			role = prefix + "." + name
+		}
+
+		table, ok := conf.Table(roles[name])
+		if !ok {
+			errs = append(errs, fmt.Errorf("role %q: expected a table", role))
+			continue
+		}
+
+		errs = append(errs, collectLooks(theme, role, nestedTables(table))...)
+
+		if len(table) != 0 && !hasLookKeys(table) {
+			continue
+		}
+
+		look, err := parseLook(table)
+		if err != nil {
+			errs = append(errs, fmt.Errorf("role %q: %w", role, err))
+			continue
+		}
+		theme.SetLook(style.Role(role), look)
+	}
+	return errs
+}
+
+func nestedTables(table map[string]any) map[string]any {
+	nested := make(map[string]any)
+	for key, value := range table {
+		if _, ok := conf.Table(value); ok {
+			nested[key] = value
+		}
+	}
+	return nested
+}
+
+func hasLookKeys(table map[string]any) bool {
+	for _, value := range table {
+		if _, ok := conf.Table(value); !ok {
+			return true
+		}
+	}
+	return false
+}
+
+func parseLook(table map[string]any) (Look, error) {
+	look := Look{}
+
+	for _, key := range sortedKeys(table) {
+		if _, ok := conf.Table(table[key]); ok {
+			continue
+		}
+
+		switch key {
+		case keyFg, keyBg:
+			color, err := ParseColor(table[key])
+			if err != nil {
+				return Look{}, fmt.Errorf("%s: %w", key, err)
+			}
+			if key == keyFg {
+				look.Paint.Fg = color
+			} else {
+				look.Paint.Bg = color
+			}
+		case keyAtoms:
+			names, ok := conf.Strings(table[key])
+			if !ok {
+				return Look{}, fmt.Errorf("%s: expected a string or a list of strings", key)
+			}
+			atom, err := ParseAtom(names...)
+			if err != nil {
+				return Look{}, err
+			}
+			look.Atom = atom
+		default:
+			return Look{}, fmt.Errorf("unknown key %q", key)
+		}
+	}
+
+	return look, nil
+}
+
+// ParseColor accepts a colour name, "#rrggbb", a 0-255 palette index or
+// "default" to leave the channel to the terminal.
+func ParseColor(value any) (style.Color, error) {
+	switch v := value.(type) {
+	case float64:
+		if v < 0 || v > 255 || v != math.Trunc(v) {
+			return style.ColorNone(), fmt.Errorf("palette index %v out of range", v)
+		}
+		return style.Indexed(uint8(v)), nil
+	case string:
+		name := strings.ToLower(strings.TrimSpace(v))
+		if name == "" || name == "default" || name == "none" {
+			return style.ColorNone(), nil
+		}
+
+		if color, ok := colorNames[name]; ok {
+			return style.Ansi(color), nil
+		}
+
+		if color, ok := style.Hex(name); ok {
+			return color, nil
+		}
+
+		return style.ColorNone(), fmt.Errorf("unknown colour %q", v)
+	}
+
+	return style.ColorNone(), fmt.Errorf("unsupported colour %v", value)
+}
+
+func ParseAtom(names ...string) (style.Atom, error) {
+	atom := style.AtmNone
+	for _, name := range names {
+		a, ok := atomNames[strings.ToLower(strings.TrimSpace(name))]
+		if !ok {
+			return style.AtmNone, fmt.Errorf("unknown attribute %q", name)
+		}
+		atom = style.MergeAtom(atom, a)
+	}
+	return atom, nil
+}
+
+func optionalString(values map[string]any, key string) (string, error) {
+	value, ok := values[key]
+	if !ok {
+		return "", nil
+	}
+
+	str, ok := value.(string)
+	if !ok {
+		return "", fmt.Errorf("%s: expected a string", key)
+	}
+
+	return str, nil
+}
+
+func optionalTable(values map[string]any, key string) (map[string]any, error) {
+	value, ok := values[key]
+	if !ok {
+		return nil, nil
+	}
+
+	table, ok := conf.Table(value)
+	if !ok {
+		return nil, fmt.Errorf("%s: expected a table", key)
+	}
+
+	return table, nil
+}
+
+func sortedKeys(values map[string]any) []string {
+	keys := make([]string, 0, len(values))
+	for key := range values {
+		keys = append(keys, key)
+	}
+	slices.Sort(keys)
+	return keys
+}
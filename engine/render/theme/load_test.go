@@ -0,0 +1,94 @@
+package theme
+
+import (
+	"testing"
+
+	assert "github.com/Rafael24595/go-assert/assert/test"
+
+	"github.com/Rafael24595/go-reacterm-core/engine/commons/conf"
+	"github.com/Rafael24595/go-reacterm-core/engine/render/style"
+)
+
+func TestParse_TOML(t *testing.T) {
+	data := []byte(`
+name = "brand"
+base = "dark"
+
+[roles.focus]
+fg = "#ff8800"
+bg = 236
+atoms = ["bold", "underline"]
+
+[roles.gutter.active]
+fg = "bright_magenta"
+
+[glyphs]
+prompt = ["❯", ">"]
+`)
+
+	theme, err := Parse(data, conf.FormatTOML)
+	assert.Nil(t, err)
+
+	assert.Equal(t, "brand", theme.Name())
+	assert.Equal(t, NameDark, theme.Base().Name())
+
+	focus := theme.Look(style.RoleFocus)
+	assert.Equal(t, style.RGB(0xff, 0x88, 0x00), focus.Paint.Fg)
+	assert.Equal(t, style.Indexed(236), focus.Paint.Bg)
+	assert.Equal(t, style.MergeAtom(style.AtmBold, style.AtmUnderline), focus.Atom)
+
+	assert.Equal(t, style.Ansi(style.BrightMagenta), theme.Look(style.RoleGutterActive).Paint.Fg)
+
+	dark, _ := Lookup(NameDark)
+	assert.Equal(t, dark.Look(style.RoleError), theme.Look(style.RoleError))
+
+	assert.Equal(t, "❯", theme.Glyph(GlyphPrompt))
+}
+
+func TestParse_JSON(t *testing.T) {
+	data := []byte(`{
+		"name": "tool",
+		"roles": {
+			"error": {"fg": "red", "atoms": "bold"},
+			"muted": {}
+		}
+	}`)
+
+	theme, err := Parse(data, conf.FormatJSON)
+	assert.Nil(t, err)
+
+	assert.Equal(t, NameDefault, theme.Base().Name())
+	assert.Equal(t, NewLook(style.AtmBold, fg(style.Red)), theme.Look(style.RoleError))
+	assert.True(t, theme.Look(style.RoleMuted).IsNone())
+}
+
+func TestParse_Errors(t *testing.T) {
+	inputs := []string{
+		`{"base": "missing"}`,
+		`{"name": 1}`,
+		`{"roles": {"focus": {"fg": "purple"}}}`,
+		`{"roles": {"focus": {"fg": 300}}}`,
+		`{"roles": {"focus": {"atoms": ["shiny"]}}}`,
+		`{"roles": {"focus": {"weight": "bold"}}}`,
+		`{"roles": {"focus": "bold"}}`,
+		`{"glyphs": {"prompt": ["a", "b", "c"]}}`,
+	}
+
+	for _, input := range inputs {
+		_, err := Parse([]byte(input), conf.FormatJSON)
+		assert.NotNil(t, err)
+	}
+}
+
+func TestParseColor(t *testing.T) {
+	color, err := ParseColor("Bright_Blue")
+	assert.Nil(t, err)
+	assert.Equal(t, style.Ansi(style.BrightBlue), color)
+
+	color, err = ParseColor("default")
+	assert.Nil(t, err)
+	assert.True(t, color.IsNone())
+
+	_, err = ParseColor(12.5)
+	assert.NotNil(t, err)
+}
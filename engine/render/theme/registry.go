@@ -0,0 +1,74 @@
+package theme
+
+import (
+	"fmt"
+	"slices"
+	"sync"
+	"sync/atomic"
+)
+
+var (
+	mutex    sync.RWMutex
+	registry = make(map[string]*Theme)
+	current  atomic.Pointer[Theme]
+)
+
+func init() {
+	for _, theme := range []*Theme{Default(), Dark(), Light(), HighContrast()} {
+		Register(theme)
+	}
+}
+
+// Register makes a theme reachable by name, replacing any theme
+// registered before under the same name.
+func Register(theme *Theme) {
+	mutex.Lock()
+	defer mutex.Unlock()
+
+	registry[theme.Name()] = theme
+}
+
+func Lookup(name string) (*Theme, bool) {
+	mutex.RLock()
+	defer mutex.RUnlock()
+
+	theme, ok := registry[name]
+	return theme, ok
+}
+
+func Names() []string {
+	mutex.RLock()
+	defer mutex.RUnlock()
+
+	names := make([]string, 0, len(registry))
+	for name := range registry {
+		names = append(names, name)
+	}
+	slices.Sort(names)
+
+	return names
+}
+
+func Current() *Theme {
+	if theme := current.Load(); theme != nil {
+		return theme
+	}
+
+	theme, _ := Lookup(NameDefault)
+	return theme
+}
+
+// Set switches the active theme, the next frame is drawn with it.
+func Set(theme *Theme) {
+	current.Store(theme)
+}
+
+func Use(name string) error {
+	theme, ok := Lookup(name)
+	if !ok {
+		return fmt.Errorf("unknown theme %q", name)
+	}
+
+	Set(theme)
+	return nil
+}
@@ -0,0 +1,133 @@
+package theme
+
+import (
+	"github.com/Rafael24595/go-reacterm-core/engine/render/style"
+	"github.com/Rafael24595/go-reacterm-core/engine/render/text"
+)
+
+type Look struct {
+	Atom  style.Atom
+	Paint style.Paint
+}
+
+func NewLook(atom style.Atom, paint style.Paint) Look {
+	return Look{
+		Atom:  atom,
+		Paint: paint,
+	}
+}
+
+func (l Look) IsNone() bool {
+	return l.Atom == style.AtmNone && l.Paint.IsNone()
+}
+
+type Theme struct {
+	name   string
+	base   *Theme
+	looks  map[style.Role]Look
+	glyphs map[Glyph]glyphSet
+}
+
+func New(name string) *Theme {
+	return &Theme{
+		name:   name,
+		base:   nil,
+		looks:  make(map[style.Role]Look),
+		glyphs: make(map[Glyph]glyphSet),
+	}
+}
+
+// Extend creates a theme that falls back to t for every role and glyph
+// it does not define itself.
+func (t *Theme) Extend(name string) *Theme {
+	theme := New(name)
+	theme.base = t
+	return theme
+}
+
+func (t *Theme) Name() string {
+	return t.name
+}
+
+func (t *Theme) Base() *Theme {
+	return t.base
+}
+
+func (t *Theme) SetLook(role style.Role, look Look) *Theme {
+	t.looks[role] = look
+	return t
+}
+
+func (t *Theme) SetGlyph(glyph Glyph, unicode, ascii string) *Theme {
+	t.glyphs[glyph] = glyphSet{
+		unicode: unicode,
+		ascii:   ascii,
+	}
+	return t
+}
+
+func (t *Theme) Look(role style.Role) Look {
+	if role.IsNone() {
+		return Look{}
+	}
+
+	for theme := t; theme != nil; theme = theme.base {
+		if look, ok := theme.looks[role]; ok {
+			return look
+		}
+	}
+
+	return Look{}
+}
+
+func (t *Theme) Glyph(glyph Glyph) string {
+	for theme := t; theme != nil; theme = theme.base {
+		if set, ok := theme.glyphs[glyph]; ok {
+			return set.text()
+		}
+	}
+
+	return defaultGlyph(glyph)
+}
+
+// ResolveLine turns the roles of a line and its fragments into concrete
+// atoms and colours. Explicit fragment colours win over the fragment
+// role, which wins over the line colours and the line role.
+func (t *Theme) ResolveLine(line text.Line) text.Line {
+	if !hasRoles(line) {
+		return line
+	}
+
+	lineLook := t.Look(line.Role)
+
+	frags := make([]text.Fragment, len(line.Text))
+	for i, f := range line.Text {
+		look := lineLook
+		if !f.Role.IsNone() {
+			look = t.Look(f.Role)
+			f.Paint = f.Paint.Inherit(look.Paint)
+		}
+
+		f.Atom = style.MergeAtom(f.Atom, look.Atom)
+		frags[i] = f
+	}
+
+	line.Text = frags
+	line.Paint = line.Paint.Inherit(lineLook.Paint)
+
+	return line
+}
+
+func hasRoles(line text.Line) bool {
+	if !line.Role.IsNone() {
+		return true
+	}
+
+	for _, f := range line.Text {
+		if !f.Role.IsNone() {
+			return true
+		}
+	}
+
+	return false
+}
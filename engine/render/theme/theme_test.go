@@ -0,0 +1,96 @@
+package theme
+
+import (
+	"slices"
+	"testing"
+
+	assert "github.com/Rafael24595/go-assert/assert/test"
+
+	"github.com/Rafael24595/go-reacterm-core/engine/render/marker"
+	"github.com/Rafael24595/go-reacterm-core/engine/render/style"
+	"github.com/Rafael24595/go-reacterm-core/engine/render/text"
+	"github.com/Rafael24595/go-reacterm-core/engine/terminal/capability"
+)
+
+func TestTheme_LookFallsBackToBase(t *testing.T) {
+	base := New("base").
+		SetLook(style.RoleFocus, NewLook(style.AtmBold, style.PaintNone()))
+
+	child := base.Extend("child").
+		SetLook(style.RoleError, NewLook(style.AtmNone, fg(style.Red)))
+
+	assert.Equal(t, style.AtmBold, child.Look(style.RoleFocus).Atom)
+	assert.Equal(t, fg(style.Red), child.Look(style.RoleError).Paint)
+	assert.True(t, child.Look(style.RoleMuted).IsNone())
+	assert.True(t, child.Look(style.RoleNone).IsNone())
+}
+
+func TestTheme_Glyph(t *testing.T) {
+	defer capability.Set(capability.Full())
+
+	theme := New("glyphs").SetGlyph(GlyphPrompt, "❯", ">>")
+
+	assert.Equal(t, "❯", theme.Glyph(GlyphPrompt))
+	assert.Equal(t, marker.LeftGutterText(), theme.Glyph(GlyphGutterLeft))
+
+	capability.Set(capability.Basic())
+
+	assert.Equal(t, ">>", theme.Glyph(GlyphPrompt))
+	assert.Equal(t, marker.AsciiLeftGutterText, theme.Glyph(GlyphGutterLeft))
+}
+
+func TestTheme_ResolveLine(t *testing.T) {
+	red := style.Ansi(style.Red)
+
+	theme := New("resolve").
+		SetLook(style.RoleHeader, NewLook(style.AtmBold, fgbg(style.White, style.Blue))).
+		SetLook(style.RoleError, NewLook(style.AtmUnderline, fg(style.Yellow)))
+
+	line := text.LineFromFragments(
+		*text.NewFragment("a"),
+		*text.NewFragment("b").SetRole(style.RoleError),
+		*text.NewFragment("c").SetRole(style.RoleError).SetFg(red),
+	).SetRole(style.RoleHeader)
+
+	resolved := theme.ResolveLine(*line)
+
+	assert.Equal(t, fgbg(style.White, style.Blue), resolved.Paint)
+
+	assert.Equal(t, style.AtmBold, resolved.Text[0].Atom)
+	assert.True(t, resolved.Text[0].Paint.IsNone())
+
+	assert.Equal(t, style.AtmUnderline, resolved.Text[1].Atom)
+	assert.Equal(t, fg(style.Yellow), resolved.Text[1].Paint)
+
+	assert.Equal(t, red, resolved.Text[2].Paint.Fg)
+
+	assert.Equal(t, style.AtmNone, line.Text[1].Atom)
+}
+
+func TestDefault_KeepsMonochromeLook(t *testing.T) {
+	theme := Default()
+
+	assert.Equal(t, NewLook(style.AtmSelect, style.PaintNone()), theme.Look(style.RoleFocus))
+	assert.Equal(t, NewLook(style.AtmBold, style.PaintNone()), theme.Look(style.RoleEmphasis))
+	assert.True(t, theme.Look(style.RoleHeader).IsNone())
+}
+
+func TestUse(t *testing.T) {
+	defer Set(nil)
+
+	assert.Equal(t, NameDefault, Current().Name())
+
+	assert.Nil(t, Use(NameDark))
+	assert.Equal(t, NameDark, Current().Name())
+
+	assert.NotNil(t, Use("unknown"))
+	assert.Equal(t, NameDark, Current().Name())
+}
+
+func TestNames_Builtin(t *testing.T) {
+	names := Names()
+
+	for _, name := range []string{NameDefault, NameDark, NameLight, NameHighContrast} {
+		assert.True(t, slices.Contains(names, name))
+	}
+}
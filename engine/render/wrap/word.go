@@ -115,6 +115,10 @@ func splitLongWord(
 		}
 
 		takenFrag, restFrag := splitFragmentAt(&frag, remaining)
+		if takenFrag.Text == "" && remaining == cols && len(current.Text) == 0 {
+			takenFrag, restFrag = splitFragmentFirst(&frag)
+		}
+
 		current.Text = append(current.Text, *takenFrag)
 
 		rest := make([]text.Fragment, 0)
@@ -4,7 +4,6 @@ import (
 	"strings"
 
 	"github.com/Rafael24595/go-reacterm-core/engine/helper/runes"
-	"github.com/Rafael24595/go-reacterm-core/engine/model/offset"
 	"github.com/Rafael24595/go-reacterm-core/engine/model/winsize"
 	"github.com/Rafael24595/go-reacterm-core/engine/render/style"
 	"github.com/Rafael24595/go-reacterm-core/engine/render/text"
@@ -210,15 +209,33 @@ func splitFragmentAt(frag *text.Fragment, cols winsize.Cols) (*text.Fragment, *t
 			CopyMeta(frag), frag
 	}
 
-	byteIndex, canBreak := runes.RuneIndexToByteIndex(frag.Text, offset.Offset(cols))
-	if !canBreak {
+	head, tail := runes.CutWidth(frag.Text, cols)
+	if tail == "" {
 		return frag, nil
 	}
 
-	taken := text.NewFragment(frag.Text[:byteIndex]).
+	taken := text.NewFragment(head).
 		CopyMeta(frag)
 
-	rest := text.NewFragment(frag.Text[byteIndex:]).
+	rest := text.NewFragment(tail).
+		CopyMeta(frag)
+
+	return taken, rest
+}
+
+// splitFragmentFirst takes the first grapheme cluster of frag, used when a
+// cluster is wider than the whole line and would never fit otherwise.
+func splitFragmentFirst(frag *text.Fragment) (*text.Fragment, *text.Fragment) {
+	rns := []rune(frag.Text)
+	end := runes.NextGrapheme(rns, 0)
+	if end >= len(rns) {
+		return frag, nil
+	}
+
+	taken := text.NewFragment(string(rns[:end])).
+		CopyMeta(frag)
+
+	rest := text.NewFragment(string(rns[end:])).
 		CopyMeta(frag)
 
 	return taken, rest
@@ -316,6 +316,23 @@ func TestWrapLine_Styles(t *testing.T) {
 	assert.Equal(t, "WORLD", lines[1].Text[0].Text)
 }
 
+func TestWrapLine_Links(t *testing.T) {
+	line := text.LineFromFragments(
+		*text.NewFragment("HELLO WORLDWIDE").SetLink("https://x.io"),
+	).SetSpec(style.SpecFromKind(style.SpcKindPaddingLeft))
+
+	lines := Line(7, line)
+
+	assert.True(t, len(lines) > 2)
+	for _, l := range lines {
+		for _, f := range l.Text {
+			if f.Text != "" {
+				assert.Equal(t, "https://x.io", f.Link)
+			}
+		}
+	}
+}
+
 func TestWrapLine_LongWord(t *testing.T) {
 	txt := "HELLO WORLD FROM GOLANG"
 
@@ -347,6 +364,28 @@ func TestWrapLine_LongWord(t *testing.T) {
 	}
 }
 
+func TestWrapLine_WideRunes(t *testing.T) {
+	line := text.NewLine("山田太郎さま")
+
+	lines := Line(5, line)
+
+	assert.Len(t, 3, lines)
+	for _, l := range lines {
+		assert.True(t, text.LineMeasure(&l, 5) <= 4)
+	}
+	assert.Equal(t, "山田", text.LineToString(&lines[0]))
+}
+
+func TestWrapLine_ClusterWiderThanLine(t *testing.T) {
+	line := text.NewLine("山a")
+
+	lines := Line(1, line)
+
+	assert.Len(t, 2, lines)
+	assert.Equal(t, "山", text.LineToString(&lines[0]))
+	assert.Equal(t, "a", text.LineToString(&lines[1]))
+}
+
 func TestWrapLine_MultipleFragments(t *testing.T) {
 	line := text.LineFromFragments(
 		*text.NewFragment("HELLO").AddAtom(style.AtmBold),
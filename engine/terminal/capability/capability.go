@@ -0,0 +1,78 @@
+package capability
+
+import "sync/atomic"
+
+type ColorDepth uint8
+
+const (
+	ColorNone ColorDepth = iota
+	Color16
+	Color256
+	ColorTrue
+)
+
+type Capabilities struct {
+	Name            string
+	Version         string
+	Color           ColorDepth
+	Unicode         bool
+	Mouse           bool
+	AltScreen       bool
+	StyledUnderline bool
+	Hyperlinks      bool
+	ExtendedKeys    bool
+}
+
+// Full assumes every capability but extended keys, which change how
+// the terminal reports input and so are only enabled once detected.
+func Full() Capabilities {
+	return Capabilities{
+		Name:            "",
+		Version:         "",
+		Color:           ColorTrue,
+		Unicode:         true,
+		Mouse:           true,
+		AltScreen:       true,
+		StyledUnderline: true,
+		Hyperlinks:      true,
+		ExtendedKeys:    false,
+	}
+}
+
+func Basic() Capabilities {
+	return Capabilities{
+		Name:            "",
+		Version:         "",
+		Color:           ColorNone,
+		Unicode:         false,
+		Mouse:           false,
+		AltScreen:       false,
+		StyledUnderline: false,
+		Hyperlinks:      false,
+		ExtendedKeys:    false,
+	}
+}
+
+func (c Capabilities) Glyph(unicode, ascii string) string {
+	if c.Unicode {
+		return unicode
+	}
+	return ascii
+}
+
+var current atomic.Pointer[Capabilities]
+
+func Current() Capabilities {
+	if c := current.Load(); c != nil {
+		return *c
+	}
+	return Full()
+}
+
+func Set(c Capabilities) {
+	current.Store(&c)
+}
+
+func Glyph(unicode, ascii string) string {
+	return Current().Glyph(unicode, ascii)
+}
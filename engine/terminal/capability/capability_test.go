@@ -0,0 +1,23 @@
+package capability
+
+import (
+	"testing"
+
+	assert "github.com/Rafael24595/go-assert/assert/test"
+)
+
+func TestCapability_DefaultIsFull(t *testing.T) {
+	current.Store(nil)
+
+	assert.Equal(t, Full(), Current())
+	assert.Equal(t, "┃", Glyph("┃", "|"))
+}
+
+func TestCapability_Set(t *testing.T) {
+	defer current.Store(nil)
+
+	Set(Basic())
+
+	assert.Equal(t, ColorNone, Current().Color)
+	assert.Equal(t, "|", Glyph("┃", "|"))
+}
@@ -2,6 +2,7 @@ package terminal
 
 import (
 	"github.com/Rafael24595/go-reacterm-core/engine/model/key"
+	"github.com/Rafael24595/go-reacterm-core/engine/model/mouse"
 	"github.com/Rafael24595/go-reacterm-core/engine/model/winsize"
 )
 
@@ -10,6 +11,10 @@ type Terminal struct {
 	OnClose      func() error
 	ResizeEvents func() <-chan winsize.Winsize
 	KeyEvents    func() <-chan key.Key
+	MouseEvents  func() <-chan mouse.Mouse
+	PasteEvents  func() <-chan string
+	ResumeEvents func() <-chan winsize.Winsize
+	Suspend      func() error
 	Size         func() (winsize.Winsize, error)
 	Clear        func() error
 	Write        func(...string) error
@@ -4,7 +4,9 @@ import (
 	"context"
 	"os"
 	"os/signal"
+	"path/filepath"
 	"syscall"
+	"time"
 
 	assert "github.com/Rafael24595/go-assert/assert/runtime"
 
@@ -28,6 +30,7 @@ import (
 	"github.com/Rafael24595/go-reacterm-core/engine/render"
 	"github.com/Rafael24595/go-reacterm-core/engine/render/processor"
 	"github.com/Rafael24595/go-reacterm-core/engine/render/styler"
+	"github.com/Rafael24595/go-reacterm-core/engine/render/text"
 	"github.com/Rafael24595/go-reacterm-core/engine/terminal"
 
 	"github.com/Rafael24595/go-reacterm-core/engine/app/cleaner/composite"
@@ -57,6 +60,7 @@ func main() {
 	transformer := transformer.WithMargin(paddingRows, paddingCols)
 	layout := makeLayout(transformer)
 	render := makeRender(transformer)
+	dump := makeDump(transformer)
 
 	cleaner := composite.NewCleaner(
 		stack.Cleanup,
@@ -75,6 +79,7 @@ func main() {
 		cleaner,
 		screen,
 	).AddPass(passes...).
+		Dump(dump, dumpPath).
 		RunWithContext(ctx)
 }
 
@@ -97,6 +102,8 @@ func makeTerminal(ctx context.Context) terminal.Terminal {
 		Context(ctx).
 		Reactive(wrapper_console.DefaultReactiveDuration).
 		Color("\x1b[0;32m").
+		AltScreen().
+		Detect().
 		ToTerminal()
 }
 
@@ -148,7 +155,9 @@ func makeRender(transformer winsize.Transformer) render.Render {
 
 	specStyler := styler.NewDefaultSpec()
 
-	standard := processor.New(*atomStyler, *specStyler)
+	standard := processor.New(*atomStyler, *specStyler).
+		Color(*wrapper_render.Colors).
+		Link(*wrapper_render.Links)
 
 	adapter := processor.WithPadding(
 		transformer,
@@ -156,5 +165,19 @@ func makeRender(transformer winsize.Transformer) render.Render {
 	)
 
 	return render.NewBuilder(adapter).
+		Origin(processor.PaddingOrigin(transformer)).
 		ToRender()
 }
+
+func makeDump(transformer winsize.Transformer) render.Processor {
+	html := processor.NewHTML(*styler.NewDefaultSpec())
+
+	return func(lines []text.Line, size winsize.Winsize) string {
+		return html.Render(lines, transformer(size))
+	}
+}
+
+func dumpPath(t time.Time) string {
+	name := "frame-" + t.Format("20060102-150405") + ".html"
+	return filepath.Join(os.TempDir(), name)
+}
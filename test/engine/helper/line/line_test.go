@@ -7,6 +7,7 @@ import (
 
 	"github.com/Rafael24595/go-reacterm-core/engine/helper/line"
 	"github.com/Rafael24595/go-reacterm-core/engine/model/offset"
+	"github.com/Rafael24595/go-reacterm-core/engine/model/winsize"
 )
 
 func TestFindLineStart_Simple(t *testing.T) {
@@ -84,7 +85,7 @@ func TestDistanceFromLF(t *testing.T) {
 	tests := []struct {
 		name string
 		from offset.Offset
-		want offset.Offset
+		want winsize.Cols
 	}{
 		{"middle of line1", 3, 3},
 		{"end of line1", 5, 5},
@@ -151,7 +152,7 @@ func TestClampToLine(t *testing.T) {
 	tests := []struct {
 		name      string
 		lineStart offset.Offset
-		col       offset.Offset
+		col       winsize.Cols
 		want      offset.Offset
 	}{
 		{"column within line", 0, 3, 3},
@@ -168,3 +169,18 @@ func TestClampToLine(t *testing.T) {
 		})
 	}
 }
+
+func TestDistanceFromLF_Wide(t *testing.T) {
+	buf := []rune("ab\n山田x")
+
+	assert.Equal(t, 4, line.DistanceFromLF(buf, 5))
+	assert.Equal(t, 2, line.DistanceFromLF(buf, 2))
+}
+
+func TestClampToLine_Wide(t *testing.T) {
+	buf := []rune("山田太\nabcdef")
+
+	assert.Equal(t, 2, line.ClampToLine(buf, 0, 4))
+	assert.Equal(t, 2, line.ClampToLine(buf, 0, 3))
+	assert.Equal(t, 3, line.ClampToLine(buf, 0, 10))
+}
@@ -33,3 +33,19 @@ func TestNumberToAlpha(t *testing.T) {
 		})
 	}
 }
+
+func TestTrim_WideText(t *testing.T) {
+	opts := helper.TextTrimOpts{
+		EllipsisText: ".",
+		EllipsisSize: 1,
+	}
+
+	assert.Equal(t, "山田.", helper.TrimRight("山田太郎", 5, opts))
+	assert.Equal(t, ".太郎", helper.TrimLeft("山田太郎", 5, opts))
+	assert.Equal(t, "山田太郎", helper.TrimRight("山田太郎", 8, opts))
+}
+
+func TestPadding_WideText(t *testing.T) {
+	assert.Equal(t, "山田  ", helper.Right("山田", 6))
+	assert.Equal(t, "  山田", helper.Left("山田", 6))
+}
@@ -1,5 +1,10 @@
 package wrapper_ansi
 
+import (
+	"fmt"
+	"strings"
+)
+
 const (
 	FullReset = ClearScreen + CursorHome
 )
@@ -9,9 +14,23 @@ const (
 	ClearScreen = "\x1b[2J"
 	ClearLine   = "\x1b[2K"
 
+	EnterAltScreen = "\x1b[?1049h"
+	ExitAltScreen  = "\x1b[?1049l"
+
 	HideCursor = "\x1b[?25l"
 	ShowCursor = "\x1b[?25h"
 
+	EnableMouse  = "\x1b[?1000h\x1b[?1002h\x1b[?1006h"
+	DisableMouse = "\x1b[?1006l\x1b[?1002l\x1b[?1000l"
+
+	EnablePaste  = "\x1b[?2004h"
+	DisablePaste = "\x1b[?2004l"
+
+	// Pushes the kitty keyboard flags and sets xterm modifyOtherKeys,
+	// terminals ignore the one they do not know.
+	EnableExtendedKeys  = "\x1b[>1u\x1b[>4;2m"
+	DisableExtendedKeys = "\x1b[>4m\x1b[<u"
+
 	Reset = "\x1b[0m"
 
 	Bold      = "\x1b[1m"
@@ -20,10 +39,68 @@ const (
 	Underline = "\x1b[4m"
 	Blink     = "\x1b[5m"
 	Reverse   = "\x1b[7m"
+	Strike    = "\x1b[9m"
+	Overline  = "\x1b[53m"
+
+	DoubleUnderline = "\x1b[4:2m"
+	CurlyUnderline  = "\x1b[4:3m"
 
 	NormalWeight = "\x1b[22m"
 	NoItalic     = "\x1b[23m"
 	NoUnderline  = "\x1b[24m"
 	NoBlink      = "\x1b[25m"
 	NoReverse    = "\x1b[27m"
+	NoStrike     = "\x1b[29m"
+	NoOverline   = "\x1b[55m"
+
+	DefaultFg = "\x1b[39m"
+	DefaultBg = "\x1b[49m"
+
+	HyperlinkEnd = "\x1b]8;;\x1b\\"
 )
+
+func CursorTo(row, col int) string {
+	return fmt.Sprintf("\x1b[%d;%dH", row, col)
+}
+
+func Fg16(index int) string {
+	if index < 8 {
+		return fmt.Sprintf("\x1b[%dm", 30+index)
+	}
+	return fmt.Sprintf("\x1b[%dm", 90+index-8)
+}
+
+func Bg16(index int) string {
+	if index < 8 {
+		return fmt.Sprintf("\x1b[%dm", 40+index)
+	}
+	return fmt.Sprintf("\x1b[%dm", 100+index-8)
+}
+
+func Fg256(index int) string {
+	return fmt.Sprintf("\x1b[38;5;%dm", index)
+}
+
+func Bg256(index int) string {
+	return fmt.Sprintf("\x1b[48;5;%dm", index)
+}
+
+func FgRGB(r, g, b int) string {
+	return fmt.Sprintf("\x1b[38;2;%d;%d;%dm", r, g, b)
+}
+
+func BgRGB(r, g, b int) string {
+	return fmt.Sprintf("\x1b[48;2;%d;%d;%dm", r, g, b)
+}
+
+// Hyperlink opens an OSC 8 link. Control characters are dropped from uri
+// so it can never terminate the sequence early.
+func Hyperlink(uri string) string {
+	clean := strings.Map(func(r rune) rune {
+		if r < 0x20 || (r >= 0x7f && r < 0xa0) {
+			return -1
+		}
+		return r
+	}, uri)
+	return "\x1b]8;;" + clean + "\x1b\\"
+}
@@ -0,0 +1,28 @@
+package platform
+
+type Signal uint8
+
+const SignalNone Signal = 0
+
+const (
+	SignalInterrupt Signal = 1 << iota
+	SignalQuit
+	SignalSuspend
+)
+
+func (s Signal) Has(signal Signal) bool {
+	return s&signal != 0
+}
+
+// RawOptions describes the raw mode requested on start. Signals lists
+// the control keys that keep raising signals through the kernel instead
+// of reaching the reader as plain input.
+type RawOptions struct {
+	Signals Signal
+}
+
+func DefaultRawOptions() RawOptions {
+	return RawOptions{
+		Signals: SignalNone,
+	}
+}
@@ -5,19 +5,51 @@ package platform
 
 import (
 	"context"
+	"errors"
+	"os"
+	"syscall"
 	"time"
 
 	"github.com/Rafael24595/go-reacterm-core/engine/model/winsize"
 )
 
-func OnStart() (uintptr, error) {
-	return 0, nil
+type State struct{}
+
+func OnStart(_ RawOptions) (State, error) {
+	return State{}, nil
+}
+
+func OnClose(_ State) error {
+	return nil
+}
+
+func Raise(_ syscall.Signal) error {
+	return nil
+}
+
+func Suspend() error {
+	return nil
 }
 
-func OnClose(rawmode uintptr) {}
+func SuspendSignals() []os.Signal {
+	return nil
+}
+
+func ResumeSignals() []os.Signal {
+	return nil
+}
+
+// PollableStdin is not supported, so terminal queries are skipped.
+func PollableStdin() (*os.File, error) {
+	return nil, errors.ErrUnsupported
+}
+
+func ReleaseStdin(_ *os.File) error {
+	return nil
+}
 
-func Size() core_winsize.Winsize {
-	return core_winsize.New(80, 150)
+func Size() (winsize.Winsize, error) {
+	return winsize.New(80, 150), nil
 }
 
 func ResizeSystemEvents(ctx context.Context, _ time.Duration) <-chan winsize.Winsize {
@@ -14,17 +14,35 @@ import (
 	"github.com/Rafael24595/go-reacterm-core/engine/model/winsize"
 )
 
-const (
-	ICANON = 0x0001
-	ECHO   = 0x0002
-)
+const disabledChar = 0
+
+type State struct {
+	termios syscall.Termios
+	saved   bool
+}
+
+func OnStart(opts RawOptions) (State, error) {
+	return enableRaw(opts)
+}
+
+func OnClose(state State) error {
+	return restoreRaw(state)
+}
+
+func Raise(sig syscall.Signal) error {
+	return syscall.Kill(syscall.Getpid(), sig)
+}
 
-func OnStart() (uintptr, error) {
-	return enableRaw()
+func Suspend() error {
+	return Raise(syscall.SIGSTOP)
 }
 
-func OnClose(rawmode uintptr) error {
-	return restoreRaw(rawmode)
+func SuspendSignals() []os.Signal {
+	return []os.Signal{syscall.SIGTSTP}
+}
+
+func ResumeSignals() []os.Signal {
+	return []os.Signal{syscall.SIGCONT}
 }
 
 type linuxWinsize struct {
@@ -84,57 +102,120 @@ func ResizeSystemEvents(ctx context.Context, _ time.Duration) <-chan winsize.Win
 	return out
 }
 
-type termios struct {
-	Iflag, Oflag, Cflag, Lflag uint32
-	Cc                         [20]byte
-	Ispeed, Ospeed             uint32
+// PollableStdin duplicates stdin in non-blocking mode, so reads on it
+// honour deadlines. The mode is shared with stdin until ReleaseStdin.
+func PollableStdin() (*os.File, error) {
+	fd, err := syscall.Dup(int(os.Stdin.Fd()))
+	if err != nil {
+		return nil, err
+	}
+
+	err = syscall.SetNonblock(fd, true)
+	if err != nil {
+		syscall.Close(fd)
+		return nil, err
+	}
+
+	return os.NewFile(uintptr(fd), os.Stdin.Name()), nil
 }
 
-func enableRaw() (uintptr, error) {
-	fd := uintptr(syscall.Stdin)
+// ReleaseStdin puts stdin back in blocking mode and closes the copy
+// returned by PollableStdin.
+func ReleaseStdin(file *os.File) error {
+	err := syscall.SetNonblock(int(file.Fd()), false)
+	if err != nil {
+		file.Close()
+		return err
+	}
 
-	var tio termios
+	return file.Close()
+}
 
-	_, _, err := syscall.Syscall6(
-		syscall.SYS_IOCTL,
-		fd,
-		uintptr(syscall.TCGETS),
-		uintptr(unsafe.Pointer(&tio)),
-		0, 0, 0,
-	)
+func enableRaw(opts RawOptions) (State, error) {
+	fd := os.Stdin.Fd()
 
-	if err != 0 {
-		return 0, err
+	original, err := getTermios(fd)
+	if err != nil {
+		return State{}, err
+	}
+
+	raw := makeRaw(original, opts)
+	if err := setTermios(fd, &raw); err != nil {
+		return State{}, err
+	}
+
+	return State{
+		termios: original,
+		saved:   true,
+	}, nil
+}
+
+func restoreRaw(state State) error {
+	if !state.saved {
+		return nil
+	}
+
+	return setTermios(os.Stdin.Fd(), &state.termios)
+}
+
+// makeRaw mirrors cfmakeraw(3), optionally keeping ISIG alive for the
+// signal keys requested in opts.
+func makeRaw(tio syscall.Termios, opts RawOptions) syscall.Termios {
+	tio.Iflag &^= syscall.IGNBRK | syscall.BRKINT | syscall.PARMRK |
+		syscall.ISTRIP | syscall.INLCR | syscall.IGNCR |
+		syscall.ICRNL | syscall.IXON
+	tio.Oflag &^= syscall.OPOST
+	tio.Lflag &^= syscall.ECHO | syscall.ECHONL | syscall.ICANON |
+		syscall.ISIG | syscall.IEXTEN
+	tio.Cflag &^= syscall.CSIZE | syscall.PARENB
+	tio.Cflag |= syscall.CS8
+
+	tio.Cc[syscall.VMIN] = 1
+	tio.Cc[syscall.VTIME] = 0
+
+	if opts.Signals == SignalNone {
+		return tio
+	}
+
+	tio.Lflag |= syscall.ISIG
+
+	if !opts.Signals.Has(SignalInterrupt) {
+		tio.Cc[syscall.VINTR] = disabledChar
+	}
+	if !opts.Signals.Has(SignalQuit) {
+		tio.Cc[syscall.VQUIT] = disabledChar
+	}
+	if !opts.Signals.Has(SignalSuspend) {
+		tio.Cc[syscall.VSUSP] = disabledChar
 	}
 
-	tio.Lflag &^= ICANON | ECHO
+	return tio
+}
 
-	tio.Cc[syscall.VMIN] = 0
-	tio.Cc[syscall.VTIME] = 1
+func getTermios(fd uintptr) (syscall.Termios, error) {
+	var tio syscall.Termios
 
-	_, _, err = syscall.Syscall6(
+	_, _, err := syscall.Syscall6(
 		syscall.SYS_IOCTL,
 		fd,
-		uintptr(syscall.TCSETS),
+		uintptr(syscall.TCGETS),
 		uintptr(unsafe.Pointer(&tio)),
 		0, 0, 0,
 	)
 
 	if err != 0 {
-		return 0, err
+		return tio, err
 	}
 
-	return uintptr(unsafe.Pointer(&tio)), nil
+	return tio, nil
 }
 
-func restoreRaw(old uintptr) error {
-	fd := os.Stdin.Fd()
-
+func setTermios(fd uintptr, tio *syscall.Termios) error {
 	_, _, err := syscall.Syscall6(
 		syscall.SYS_IOCTL,
 		fd,
 		uintptr(syscall.TCSETS),
-		old,
+		uintptr(unsafe.Pointer(tio)),
 		0, 0, 0,
 	)
 
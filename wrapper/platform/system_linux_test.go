@@ -0,0 +1,55 @@
+//go:build !mock_cmd && linux
+// +build !mock_cmd,linux
+
+package platform
+
+import (
+	"syscall"
+	"testing"
+
+	assert "github.com/Rafael24595/go-assert/assert/test"
+)
+
+func cookedTermios() syscall.Termios {
+	tio := syscall.Termios{
+		Iflag: syscall.ICRNL | syscall.IXON | syscall.BRKINT,
+		Oflag: syscall.OPOST,
+		Cflag: syscall.CS7 | syscall.PARENB,
+		Lflag: syscall.ECHO | syscall.ICANON | syscall.ISIG | syscall.IEXTEN,
+	}
+	tio.Cc[syscall.VINTR] = 0x03
+	tio.Cc[syscall.VQUIT] = 0x1c
+	tio.Cc[syscall.VSUSP] = 0x1a
+	return tio
+}
+
+func TestMakeRaw(t *testing.T) {
+	original := cookedTermios()
+
+	raw := makeRaw(original, DefaultRawOptions())
+
+	assert.Equal(t, uint32(0), raw.Iflag&(syscall.ICRNL|syscall.IXON|syscall.BRKINT))
+	assert.Equal(t, uint32(0), raw.Oflag&syscall.OPOST)
+	assert.Equal(t, uint32(0), raw.Lflag&(syscall.ECHO|syscall.ICANON|syscall.ISIG|syscall.IEXTEN))
+	assert.Equal(t, uint32(syscall.CS8), raw.Cflag&syscall.CSIZE)
+	assert.Equal(t, uint32(0), raw.Cflag&syscall.PARENB)
+	assert.Equal(t, uint8(1), raw.Cc[syscall.VMIN])
+	assert.Equal(t, uint8(0), raw.Cc[syscall.VTIME])
+
+	assert.Equal(t, uint32(syscall.OPOST), original.Oflag)
+}
+
+func TestMakeRaw_KeepsSignals(t *testing.T) {
+	raw := makeRaw(cookedTermios(), RawOptions{
+		Signals: SignalInterrupt,
+	})
+
+	assert.Equal(t, uint32(syscall.ISIG), raw.Lflag&syscall.ISIG)
+	assert.Equal(t, uint8(0x03), raw.Cc[syscall.VINTR])
+	assert.Equal(t, uint8(disabledChar), raw.Cc[syscall.VQUIT])
+	assert.Equal(t, uint8(disabledChar), raw.Cc[syscall.VSUSP])
+}
+
+func TestRestoreRaw_Unsaved(t *testing.T) {
+	assert.Nil(t, restoreRaw(State{}))
+}
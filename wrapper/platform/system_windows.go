@@ -5,6 +5,8 @@ package platform
 
 import (
 	"context"
+	"errors"
+	"os"
 	"syscall"
 	"time"
 	"unsafe"
@@ -45,22 +47,52 @@ const (
 	ENABLE_VIRTUAL_TERMINAL_INPUT      = uint32(0x0200)
 )
 
-func OnStart() (uintptr, error) {
+type State struct {
+	mode  uint32
+	saved bool
+}
+
+func OnStart(opts RawOptions) (State, error) {
 	err := sendDummyKey()
 	if err != nil {
-		return 0, err
+		return State{}, err
 	}
 
 	err = enableANSI()
 	if err != nil {
-		return 0, err
+		return State{}, err
 	}
 
-	return enableRaw()
+	return enableRaw(opts)
+}
+
+func OnClose(state State) error {
+	return restoreRaw(state)
+}
+
+func Raise(_ syscall.Signal) error {
+	return nil
+}
+
+func Suspend() error {
+	return nil
 }
 
-func OnClose(rawmode uintptr) error {
-	return restoreRaw(rawmode)
+func SuspendSignals() []os.Signal {
+	return nil
+}
+
+func ResumeSignals() []os.Signal {
+	return nil
+}
+
+// PollableStdin is not supported, so terminal queries are skipped.
+func PollableStdin() (*os.File, error) {
+	return nil, errors.ErrUnsupported
+}
+
+func ReleaseStdin(_ *os.File) error {
+	return nil
 }
 
 func Size() (winsize.Winsize, error) {
@@ -114,7 +146,7 @@ func enableANSI() error {
 	return nil
 }
 
-func enableRaw() (uintptr, error) {
+func enableRaw(opts RawOptions) (State, error) {
 	handle := syscall.Handle(syscall.Stdin)
 
 	getConsoleMode := kernel32.NewProc("GetConsoleMode")
@@ -127,14 +159,17 @@ func enableRaw() (uintptr, error) {
 	)
 
 	if ret == 0 {
-		return 0, err
+		return State{}, err
 	}
 
 	oldMode := mode
-	mode &^= ENABLE_PROCESSED_INPUT |
-		ENABLE_LINE_INPUT |
+	mode &^= ENABLE_LINE_INPUT |
 		ENABLE_ECHO_INPUT
 
+	if !opts.Signals.Has(SignalInterrupt) {
+		mode &^= ENABLE_PROCESSED_INPUT
+	}
+
 	mode |= ENABLE_VIRTUAL_TERMINAL_INPUT
 
 	ret, _, err = setConsoleMode.Call(
@@ -143,20 +178,27 @@ func enableRaw() (uintptr, error) {
 	)
 
 	if ret == 0 {
-		return 0, err
+		return State{}, err
 	}
 
-	return uintptr(oldMode), nil
+	return State{
+		mode:  oldMode,
+		saved: true,
+	}, nil
 }
 
-func restoreRaw(old uintptr) error {
+func restoreRaw(state State) error {
+	if !state.saved {
+		return nil
+	}
+
 	handle := syscall.Handle(syscall.Stdin)
 
 	setConsoleMode := kernel32.NewProc("SetConsoleMode")
 
 	ret, _, err := setConsoleMode.Call(
 		uintptr(handle),
-		old,
+		uintptr(state.mode),
 	)
 
 	if ret == 0 {
@@ -0,0 +1,56 @@
+package wrapper_render
+
+import (
+	"github.com/Rafael24595/go-reacterm-core/engine/render/style"
+	"github.com/Rafael24595/go-reacterm-core/engine/render/styler"
+
+	wrapper_ansi "github.com/Rafael24595/go-reacterm-core/wrapper/ansi"
+)
+
+var Colors = styler.NewColor(func(text string, paint, parent style.Paint) string {
+	if text == "" {
+		return text
+	}
+
+	open := paintSequence(paint, parent)
+	restore := paintSequence(parent, paint)
+
+	return open + text + restore
+})
+
+func paintSequence(paint, from style.Paint) string {
+	sequence := ""
+	if paint.Fg != from.Fg {
+		sequence += FgSequence(paint.Fg)
+	}
+	if paint.Bg != from.Bg {
+		sequence += BgSequence(paint.Bg)
+	}
+	return sequence
+}
+
+func FgSequence(color style.Color) string {
+	switch color.Kind {
+	case style.ColorKindAnsi:
+		return wrapper_ansi.Fg16(int(color.Value))
+	case style.ColorKindIndexed:
+		return wrapper_ansi.Fg256(int(color.Value))
+	case style.ColorKindRGB:
+		r, g, b := color.Components()
+		return wrapper_ansi.FgRGB(int(r), int(g), int(b))
+	}
+	return wrapper_ansi.DefaultFg
+}
+
+func BgSequence(color style.Color) string {
+	switch color.Kind {
+	case style.ColorKindAnsi:
+		return wrapper_ansi.Bg16(int(color.Value))
+	case style.ColorKindIndexed:
+		return wrapper_ansi.Bg256(int(color.Value))
+	case style.ColorKindRGB:
+		r, g, b := color.Components()
+		return wrapper_ansi.BgRGB(int(r), int(g), int(b))
+	}
+	return wrapper_ansi.DefaultBg
+}
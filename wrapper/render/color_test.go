@@ -0,0 +1,63 @@
+package wrapper_render
+
+import (
+	"testing"
+
+	assert "github.com/Rafael24595/go-assert/assert/test"
+
+	"github.com/Rafael24595/go-reacterm-core/engine/model/winsize"
+	"github.com/Rafael24595/go-reacterm-core/engine/render/processor"
+	"github.com/Rafael24595/go-reacterm-core/engine/render/style"
+	"github.com/Rafael24595/go-reacterm-core/engine/render/styler"
+	"github.com/Rafael24595/go-reacterm-core/engine/render/text"
+)
+
+func TestColorSequences(t *testing.T) {
+	tests := []struct {
+		name  string
+		color style.Color
+		fg    string
+		bg    string
+	}{
+		{"ansi", style.Ansi(style.Red), "\x1b[31m", "\x1b[41m"},
+		{"bright", style.Ansi(style.BrightCyan), "\x1b[96m", "\x1b[106m"},
+		{"indexed", style.Indexed(208), "\x1b[38;5;208m", "\x1b[48;5;208m"},
+		{"rgb", style.RGB(10, 20, 30), "\x1b[38;2;10;20;30m", "\x1b[48;2;10;20;30m"},
+		{"none", style.ColorNone(), "\x1b[39m", "\x1b[49m"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.fg, FgSequence(tt.color))
+			assert.Equal(t, tt.bg, BgSequence(tt.color))
+		})
+	}
+}
+
+func TestColors_RestoresParent(t *testing.T) {
+	parent := style.Paint{Bg: style.Ansi(style.Blue)}
+	paint := style.Paint{Fg: style.Ansi(style.Red), Bg: style.Ansi(style.Blue)}
+
+	result := Colors.Apply("x", paint, parent)
+
+	assert.Equal(t, "\x1b[31mx\x1b[39m", result)
+}
+
+func TestStandard_Colors(t *testing.T) {
+	atom := styler.NewDefaultAtom().Push(Atoms.ToPairsSlice()...)
+	standard := processor.New(*atom, *styler.NewDefaultSpec()).
+		Color(*Colors)
+
+	red := style.Ansi(style.Red)
+
+	line := text.LineFromFragments(
+		*text.NewFragment("ab").SetFg(red),
+		*text.NewFragment("cd").SetFg(red),
+		*text.NewFragment("ef"),
+	).SetBg(style.Ansi(style.Black))
+
+	result := standard.Render([]text.Line{*line}, winsize.New(1, 10))
+
+	assert.Len(t, 1, result)
+	assert.Equal(t, "\x1b[40m\x1b[31mabcd\x1b[39mef\x1b[49m", result[0])
+}
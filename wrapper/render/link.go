@@ -0,0 +1,16 @@
+package wrapper_render
+
+import (
+	"github.com/Rafael24595/go-reacterm-core/engine/render/styler"
+	"github.com/Rafael24595/go-reacterm-core/engine/terminal/capability"
+
+	wrapper_ansi "github.com/Rafael24595/go-reacterm-core/wrapper/ansi"
+)
+
+var Links = styler.NewLink(func(text, uri string) string {
+	if text == "" || !capability.Current().Hyperlinks {
+		return text
+	}
+
+	return wrapper_ansi.Hyperlink(uri) + text + wrapper_ansi.HyperlinkEnd
+})
@@ -4,6 +4,7 @@ import (
 	"github.com/Rafael24595/go-reacterm-core/engine/commons/structure/dict"
 	"github.com/Rafael24595/go-reacterm-core/engine/render/style"
 	"github.com/Rafael24595/go-reacterm-core/engine/render/styler"
+	"github.com/Rafael24595/go-reacterm-core/engine/terminal/capability"
 
 	wrapper_ansi "github.com/Rafael24595/go-reacterm-core/wrapper/ansi"
 )
@@ -12,17 +13,52 @@ func pa(k style.Atom, s styler.AtomStyler) dict.Pair[style.Atom, styler.AtomStyl
 	return dict.NewPair(k, s)
 }
 
+// Bold and dim share SGR 22 and every underline shares SGR 24. Stylers
+// nest around the same text, so their resets always land back to back
+// and never end an attribute that still has text to cover.
 var Atoms = dict.NewInmutableLinkedMap(
 	pa(style.AtmBold, func(text string) string {
-		if text == "" {
-			return text
-		}
-		return wrapper_ansi.Bold + text + wrapper_ansi.NormalWeight
+		return wrap(text, wrapper_ansi.Bold, wrapper_ansi.NormalWeight)
 	}),
 	pa(style.AtmSelect, func(text string) string {
-		if text == "" {
-			return text
-		}
-		return wrapper_ansi.Reverse + text + wrapper_ansi.NoReverse
+		return wrap(text, wrapper_ansi.Reverse, wrapper_ansi.NoReverse)
+	}),
+	pa(style.AtmDim, func(text string) string {
+		return wrap(text, wrapper_ansi.Dim, wrapper_ansi.NormalWeight)
+	}),
+	pa(style.AtmItalic, func(text string) string {
+		return wrap(text, wrapper_ansi.Italic, wrapper_ansi.NoItalic)
+	}),
+	pa(style.AtmCurlyUnderline, func(text string) string {
+		return wrap(text, styledUnderline(wrapper_ansi.CurlyUnderline), wrapper_ansi.NoUnderline)
+	}),
+	pa(style.AtmDoubleUnderline, func(text string) string {
+		return wrap(text, styledUnderline(wrapper_ansi.DoubleUnderline), wrapper_ansi.NoUnderline)
+	}),
+	pa(style.AtmUnderline, func(text string) string {
+		return wrap(text, wrapper_ansi.Underline, wrapper_ansi.NoUnderline)
+	}),
+	pa(style.AtmStrike, func(text string) string {
+		return wrap(text, wrapper_ansi.Strike, wrapper_ansi.NoStrike)
+	}),
+	pa(style.AtmBlink, func(text string) string {
+		return wrap(text, wrapper_ansi.Blink, wrapper_ansi.NoBlink)
+	}),
+	pa(style.AtmOverline, func(text string) string {
+		return wrap(text, wrapper_ansi.Overline, wrapper_ansi.NoOverline)
 	}),
 )
+
+func wrap(text, open, close string) string {
+	if text == "" {
+		return text
+	}
+	return open + text + close
+}
+
+func styledUnderline(sequence string) string {
+	if capability.Current().StyledUnderline {
+		return sequence
+	}
+	return wrapper_ansi.Underline
+}
@@ -6,6 +6,8 @@ import (
 	assert "github.com/Rafael24595/go-assert/assert/test"
 
 	"github.com/Rafael24595/go-reacterm-core/engine/render/style"
+	"github.com/Rafael24595/go-reacterm-core/engine/render/styler"
+	"github.com/Rafael24595/go-reacterm-core/engine/terminal/capability"
 	wrapper_ansi "github.com/Rafael24595/go-reacterm-core/wrapper/ansi"
 )
 
@@ -28,6 +30,36 @@ func TestAtomsStyler(t *testing.T) {
 			input:    "Ziglang",
 			expected: wrapper_ansi.Reverse + "Ziglang" + wrapper_ansi.NoReverse,
 		},
+		{
+			name:     "italic transformation",
+			atom:     style.AtmItalic,
+			input:    "Rust",
+			expected: wrapper_ansi.Italic + "Rust" + wrapper_ansi.NoItalic,
+		},
+		{
+			name:     "dim transformation",
+			atom:     style.AtmDim,
+			input:    "C",
+			expected: wrapper_ansi.Dim + "C" + wrapper_ansi.NormalWeight,
+		},
+		{
+			name:     "strike transformation",
+			atom:     style.AtmStrike,
+			input:    "Perl",
+			expected: wrapper_ansi.Strike + "Perl" + wrapper_ansi.NoStrike,
+		},
+		{
+			name:     "overline transformation",
+			atom:     style.AtmOverline,
+			input:    "Lua",
+			expected: wrapper_ansi.Overline + "Lua" + wrapper_ansi.NoOverline,
+		},
+		{
+			name:     "blink transformation",
+			atom:     style.AtmBlink,
+			input:    "Ada",
+			expected: wrapper_ansi.Blink + "Ada" + wrapper_ansi.NoBlink,
+		},
 		{
 			name:     "empty string",
 			atom:     style.AtmBold,
@@ -46,3 +78,38 @@ func TestAtomsStyler(t *testing.T) {
 		})
 	}
 }
+
+func TestAtomsStyler_StyledUnderline(t *testing.T) {
+	defer capability.Set(capability.Full())
+
+	fn, ok := Atoms.Get(style.AtmCurlyUnderline)
+	assert.True(t, ok)
+
+	capability.Set(capability.Full())
+	assert.Equal(t, wrapper_ansi.CurlyUnderline+"x"+wrapper_ansi.NoUnderline, fn("x"))
+
+	capability.Set(capability.Basic())
+	assert.Equal(t, wrapper_ansi.Underline+"x"+wrapper_ansi.NoUnderline, fn("x"))
+}
+
+func TestLinks(t *testing.T) {
+	defer capability.Set(capability.Full())
+
+	capability.Set(capability.Full())
+	expected := wrapper_ansi.Hyperlink("https://x.io") + "x" + wrapper_ansi.HyperlinkEnd
+	assert.Equal(t, expected, Links.Apply("x", "https://x.io"))
+	assert.Equal(t, "x", Links.Apply("x", ""))
+
+	capability.Set(capability.Basic())
+	assert.Equal(t, "x", Links.Apply("x", "https://x.io"))
+}
+
+func TestAtomsStyler_Nested(t *testing.T) {
+	atom := styler.NewDefaultAtom().Push(Atoms.ToPairsSlice()...)
+
+	result := atom.Apply("ab", style.AtmBold, style.AtmDim, style.AtmUpper)
+
+	expected := wrapper_ansi.Dim + wrapper_ansi.Bold + "AB" +
+		wrapper_ansi.NormalWeight + wrapper_ansi.NormalWeight
+	assert.Equal(t, expected, result)
+}
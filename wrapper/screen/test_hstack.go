@@ -14,6 +14,7 @@ import (
 	"github.com/Rafael24595/go-reacterm-core/engine/layout/drawable/stream/pipeline/drain"
 	"github.com/Rafael24595/go-reacterm-core/engine/layout/drawable/stream/pipeline/position"
 	"github.com/Rafael24595/go-reacterm-core/engine/model/winsize"
+	"github.com/Rafael24595/go-reacterm-core/engine/render/marker"
 	"github.com/Rafael24595/go-reacterm-core/engine/render/style"
 	"github.com/Rafael24595/go-reacterm-core/engine/render/text"
 )
@@ -51,7 +52,11 @@ func makeKernel() drawable.Unit {
 		*text.NewLine(`  /  \__/      \      \`),
 	)
 
-	box := box.Wrap(ascii)
+	box := box.New(ascii).
+		Style(marker.BoxRounded).
+		Title("Landscape").
+		Footer("1/1", style.Right).
+		ToUnit()
 
 	padding := position.New().
 		MarginX(1).
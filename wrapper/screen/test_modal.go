@@ -20,5 +20,6 @@ func NewTestModal() screen.Node {
 			input.NewMenuOption("3", *text.NewFragment("Option_3"), NewTestSelect),
 			input.NewMenuOption("4", *text.NewFragment("Option_4"), NewTestSelect),
 		}...).
+		Over(NewTestSelect()).
 		ToNode()
 }
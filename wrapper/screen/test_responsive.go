@@ -0,0 +1,16 @@
+package wrapper_screen
+
+import (
+	"github.com/Rafael24595/go-reacterm-core/engine/app/screen"
+	"github.com/Rafael24595/go-reacterm-core/engine/app/screen/node/partial/responsive"
+	"github.com/Rafael24595/go-reacterm-core/engine/config/breakpoint"
+	"github.com/Rafael24595/go-reacterm-core/engine/model/winsize"
+)
+
+func NewTestResponsive() screen.Node {
+	return responsive.New(makeArticle()).
+		SetName("responsive-test").
+		When(breakpoint.MinCols(100), NewTestSplit()).
+		MinSize(winsize.New(12, 40)).
+		ToNode()
+}
@@ -30,7 +30,11 @@ func NewTestSelect() screen.Node {
 		input.NewMenuOption("opt_chk", *text.NewFragment("[Prim] Option Check"), NewTestCheck),
 		input.NewMenuOption("opt_chk", *text.NewFragment("[Prim] Option TextInput"), NewTestTextInput),
 		input.NewMenuOption("opt_frm", *text.NewFragment("[Comp] Option Form"), NewTestForm),
+		input.NewMenuOption("opt_spl", *text.NewFragment("[Comp] Option Split"), NewTestSplit),
+		input.NewMenuOption("opt_tab", *text.NewFragment("[Comp] Option Tabs"), NewTestTabs),
+		input.NewMenuOption("opt_rsp", *text.NewFragment("[Comp] Option Responsive"), NewTestResponsive),
 		input.NewMenuOption("opt_hsk", *text.NewFragment("[Demo] Option HStack"), NewTestHStack),
+		input.NewMenuOption("opt_vpt", *text.NewFragment("[Demo] Option Viewport"), NewTestViewport),
 	)
 
 	optsSize := len(options)
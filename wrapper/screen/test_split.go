@@ -0,0 +1,17 @@
+package wrapper_screen
+
+import (
+	"github.com/Rafael24595/go-reacterm-core/engine/app/screen"
+	"github.com/Rafael24595/go-reacterm-core/engine/app/screen/node/partial/split"
+)
+
+func NewTestSplit() screen.Node {
+	return split.New().
+		SetName("split-test").
+		SetRatios(40, 60).
+		AddNode(
+			makeArticle(),
+			makeTextArea(),
+		).
+		ToNode()
+}
@@ -0,0 +1,15 @@
+package wrapper_screen
+
+import (
+	"github.com/Rafael24595/go-reacterm-core/engine/app/screen"
+	"github.com/Rafael24595/go-reacterm-core/engine/app/screen/node/partial/tabs"
+)
+
+func NewTestTabs() screen.Node {
+	return tabs.New().
+		SetName("tabs-test").
+		AddTab("Article", makeArticle()).
+		AddTab("TextArea", makeTextArea()).
+		AddTab("Viewport", NewTestViewport()).
+		ToNode()
+}
@@ -0,0 +1,112 @@
+package wrapper_screen
+
+import (
+	"fmt"
+
+	"github.com/Rafael24595/go-reacterm-core/engine/app/screen"
+	"github.com/Rafael24595/go-reacterm-core/engine/app/state"
+	"github.com/Rafael24595/go-reacterm-core/engine/app/viewmodel"
+	"github.com/Rafael24595/go-reacterm-core/engine/config/layer"
+	"github.com/Rafael24595/go-reacterm-core/engine/layout/drawable"
+	"github.com/Rafael24595/go-reacterm-core/engine/layout/drawable/decorator/viewport"
+	"github.com/Rafael24595/go-reacterm-core/engine/layout/drawable/primitive/line"
+	"github.com/Rafael24595/go-reacterm-core/engine/layout/drawable/spatial/stack"
+	"github.com/Rafael24595/go-reacterm-core/engine/model/key"
+	"github.com/Rafael24595/go-reacterm-core/engine/model/winsize"
+	"github.com/Rafael24595/go-reacterm-core/engine/render/style"
+	"github.com/Rafael24595/go-reacterm-core/engine/render/text"
+)
+
+var viewportDefinition = screen.NewDefinition(
+	map[key.Action]key.Descriptor{
+		key.ActionTab:       {Code: []string{"TAB"}, Detail: "Switch list"},
+		key.ActionArrowUp:   {Code: []string{"↑"}, Detail: "Scroll up"},
+		key.ActionArrowDown: {Code: []string{"↓"}, Detail: "Scroll down"},
+	},
+	[]key.Action{
+		key.ActionTab,
+		key.ActionArrowUp,
+		key.ActionArrowDown,
+		key.ActionPageUp,
+		key.ActionPageDown,
+		key.ActionHome,
+		key.ActionEnd,
+	},
+)
+
+type testViewport struct {
+	lists  []*viewport.State
+	active int
+}
+
+func NewTestViewport() screen.Node {
+	n := &testViewport{
+		lists:  []*viewport.State{viewport.NewState(), viewport.NewState()},
+		active: 0,
+	}
+
+	return screen.NewBuilder().
+		Name("viewport-test").
+		NameToStack().
+		WithoutInit().
+		Keys(n.keys).
+		Tick(n.tick).
+		View(n.view).
+		ToNode()
+}
+
+func (n *testViewport) keys() screen.Definition {
+	return viewportDefinition
+}
+
+func (n *testViewport) tick(uiState *state.UIState, event screen.Event) screen.Result {
+	if event.Key.Code == key.ActionTab {
+		n.active = (n.active + 1) % len(n.lists)
+	} else {
+		n.lists[n.active].Scroll(event.Key.Code)
+	}
+
+	return screen.ResultFromUIState(uiState)
+}
+
+func (n *testViewport) view(state.UIState) viewmodel.ViewModel {
+	vm := viewmodel.New()
+
+	vm.Header.Push(
+		line.UnitFromLines(
+			*text.NewLine("Vivamus mollis porttitor"),
+			*text.NewLine("=", style.SpecFromKind(style.SpcKindFill)),
+		),
+	)
+
+	hstack := stack.NewHStack()
+	for i, list := range n.lists {
+		hstack.PushLayer(
+			n.makeList(i, list),
+			layer.Percent[winsize.Cols](50),
+		)
+	}
+
+	vm.Kernel.Push(hstack.ToUnit())
+
+	return *vm
+}
+
+func (n *testViewport) makeList(index int, list *viewport.State) drawable.Unit {
+	lines := make([]text.Line, 60)
+	for i := range lines {
+		lines[i] = *text.NewLine(fmt.Sprintf("List %d - item %d", index+1, i+1))
+		if index == n.active {
+			lines[i].SetRole(style.RoleEmphasis)
+		}
+	}
+
+	return stack.NewVStack().
+		PushLayer(
+			viewport.New(line.UnitFromLines(lines...), list).
+				Scrollbar().
+				ToUnit(),
+			layer.Fixed[winsize.Rows](12),
+		).
+		ToUnit()
+}
@@ -4,9 +4,13 @@ import (
 	"context"
 	"time"
 
+	"github.com/Rafael24595/go-reacterm-core/engine/model/keymap"
 	"github.com/Rafael24595/go-reacterm-core/engine/model/winsize"
 	"github.com/Rafael24595/go-reacterm-core/engine/terminal"
+	"github.com/Rafael24595/go-reacterm-core/engine/terminal/capability"
 	"github.com/Rafael24595/go-reacterm-core/wrapper/platform"
+	wrapper_grid "github.com/Rafael24595/go-reacterm-core/wrapper/terminal/grid"
+	wrapper_probe "github.com/Rafael24595/go-reacterm-core/wrapper/terminal/probe"
 	wrapper_reader "github.com/Rafael24595/go-reacterm-core/wrapper/terminal/reader"
 )
 
@@ -15,6 +19,11 @@ type ConsoleBuilder struct {
 	strategy resizeStrategy
 	reader   *wrapper_reader.KeyReader
 	color    string
+	alt      bool
+	diff     bool
+	caps     *capability.Capabilities
+	query    time.Duration
+	signals  platform.Signal
 }
 
 func NewBuilder() *ConsoleBuilder {
@@ -23,6 +32,11 @@ func NewBuilder() *ConsoleBuilder {
 		strategy: defaultStrategy(),
 		reader:   wrapper_reader.New(),
 		color:    "",
+		alt:      false,
+		diff:     true,
+		caps:     nil,
+		query:    0,
+		signals:  platform.SignalNone,
 	}
 }
 
@@ -50,11 +64,65 @@ func (b *ConsoleBuilder) Color(color string) *ConsoleBuilder {
 	return b
 }
 
+func (b *ConsoleBuilder) AltScreen() *ConsoleBuilder {
+	b.alt = true
+	return b
+}
+
+func (b *ConsoleBuilder) FullRedraw() *ConsoleBuilder {
+	b.diff = false
+	return b
+}
+
+func (b *ConsoleBuilder) Capabilities(caps capability.Capabilities) *ConsoleBuilder {
+	b.caps = &caps
+	return b
+}
+
+func (b *ConsoleBuilder) Detect() *ConsoleBuilder {
+	return b.Capabilities(wrapper_probe.Detect())
+}
+
+func (b *ConsoleBuilder) Query(timeout time.Duration) *ConsoleBuilder {
+	b.query = timeout
+	return b
+}
+
+// Signals keeps the given control keys raising signals through the
+// kernel. By default every key reaches the reader as input.
+func (b *ConsoleBuilder) Signals(signals ...platform.Signal) *ConsoleBuilder {
+	for _, signal := range signals {
+		b.signals |= signal
+	}
+	return b
+}
+
+func (b *ConsoleBuilder) Keymap(keymap *keymap.Keymap) *ConsoleBuilder {
+	b.reader.Keymap(keymap)
+	keymap.Apply()
+	return b
+}
+
 func (b *ConsoleBuilder) Build() *Console {
 	console := newConsole()
 	console.context = b.context
+	console.reader = b.reader
 	console.strategy = b.strategy
-	console.color = b.color
+	console.altScreen = b.alt
+	console.query = b.query
+	console.rawopts = platform.RawOptions{Signals: b.signals}
+
+	if b.caps != nil {
+		console.caps = *b.caps
+		capability.Set(*b.caps)
+	}
+
+	console.color = degradeColor(b.color, console.caps.Color)
+
+	if b.diff {
+		console.screen = wrapper_grid.New(console.color).
+			Depth(console.caps.Color)
+	}
 
 	return console
 }
@@ -62,3 +130,11 @@ func (b *ConsoleBuilder) Build() *Console {
 func (b *ConsoleBuilder) ToTerminal() terminal.Terminal {
 	return b.Build().ToTerminal()
 }
+
+func degradeColor(color string, depth capability.ColorDepth) string {
+	style := wrapper_grid.ParseSequence(color).Degrade(depth)
+	if style.Empty() {
+		return ""
+	}
+	return style.Sequence()
+}
@@ -3,36 +3,70 @@ package wrapper_console
 import (
 	"context"
 	"fmt"
+	"os"
+	"os/signal"
 	"strings"
+	"sync"
+	"syscall"
+	"time"
 
+	"github.com/Rafael24595/go-log/log"
 	"github.com/Rafael24595/go-reacterm-core/engine/model/key"
+	"github.com/Rafael24595/go-reacterm-core/engine/model/mouse"
 	"github.com/Rafael24595/go-reacterm-core/engine/model/winsize"
 	"github.com/Rafael24595/go-reacterm-core/engine/terminal"
+	"github.com/Rafael24595/go-reacterm-core/engine/terminal/capability"
 	"github.com/Rafael24595/go-reacterm-core/wrapper/platform"
 
 	wrapper_ansi "github.com/Rafael24595/go-reacterm-core/wrapper/ansi"
+	wrapper_grid "github.com/Rafael24595/go-reacterm-core/wrapper/terminal/grid"
+	wrapper_probe "github.com/Rafael24595/go-reacterm-core/wrapper/terminal/probe"
 	wrapper_reader "github.com/Rafael24595/go-reacterm-core/wrapper/terminal/reader"
 )
 
+const mouseBuffer = 16
+
+// Raw mode disables output post-processing, so full redraws carry
+// their own carriage return.
+const lineBreak = "\r\n"
+
 type Console struct {
 	context    context.Context
 	strategy   resizeStrategy
 	keyChan    chan key.Key
+	mouseChan  chan mouse.Mouse
+	pasteChan  chan string
 	resizeChan chan winsize.Winsize
+	resumeChan chan winsize.Winsize
 	reader     *wrapper_reader.KeyReader
 	buffer     *consoleBuffer
-	rawmode    uintptr
+	screen     *wrapper_grid.Screen
+	rawmode    platform.State
+	rawopts    platform.RawOptions
 	color      string
+	altScreen  bool
+	caps       capability.Capabilities
+	query      time.Duration
+	mutex      sync.Mutex
+	suspended  bool
+	closed     bool
+	closeOnce  sync.Once
+	closeErr   error
 }
 
 func newConsole() *Console {
-	winsize, _ := platform.Size()
+	size, _ := platform.Size()
 	return &Console{
-		context:  context.Background(),
-		strategy: defaultStrategy(),
-		reader:   wrapper_reader.New(),
-		buffer:   newBuffer(winsize),
-		color:    "",
+		context:    context.Background(),
+		strategy:   defaultStrategy(),
+		reader:     wrapper_reader.New(),
+		buffer:     newBuffer(size),
+		resumeChan: make(chan winsize.Winsize, 1),
+		color:      "",
+		altScreen:  false,
+		caps:       capability.Full(),
+		rawopts:    platform.DefaultRawOptions(),
+		query:      0,
 	}
 }
 
@@ -42,6 +76,10 @@ func (t *Console) ToTerminal() terminal.Terminal {
 		OnClose:      t.OnClose,
 		ResizeEvents: t.ResizeEvents,
 		KeyEvents:    t.KeyEvents,
+		MouseEvents:  t.MouseEvents,
+		PasteEvents:  t.PasteEvents,
+		ResumeEvents: t.ResumeEvents,
+		Suspend:      t.Suspend,
 		Size:         t.Size,
 		Clear:        t.Clear,
 		Write:        t.Write,
@@ -51,29 +89,240 @@ func (t *Console) ToTerminal() terminal.Terminal {
 }
 
 func (t *Console) OnStart() error {
-	rawmode, err := platform.OnStart()
+	rawmode, err := platform.OnStart(t.rawopts)
 	if err != nil {
 		return err
 	}
 
 	t.rawmode = rawmode
 
-	fmt.Print(t.color + wrapper_ansi.FullReset + wrapper_ansi.HideCursor)
+	if t.query > 0 {
+		t.queryCapabilities()
+	}
+
+	t.prepare()
+
+	go t.watchTermination()
+	t.watchJobControl()
 
 	return nil
 }
 
+func (t *Console) prepare() {
+	fmt.Print(t.enterSequence())
+
+	if t.screen != nil {
+		t.screen.Invalidate()
+	}
+}
+
+func (t *Console) enterSequence() string {
+	var sequence strings.Builder
+
+	if t.useAltScreen() {
+		sequence.WriteString(wrapper_ansi.EnterAltScreen)
+	}
+
+	sequence.WriteString(t.color + wrapper_ansi.FullReset + wrapper_ansi.HideCursor + wrapper_ansi.EnablePaste)
+
+	if t.caps.Mouse {
+		sequence.WriteString(wrapper_ansi.EnableMouse)
+	}
+
+	if t.caps.ExtendedKeys {
+		sequence.WriteString(wrapper_ansi.EnableExtendedKeys)
+	}
+
+	return sequence.String()
+}
+
 func (t *Console) OnClose() error {
-	err := platform.OnClose(t.rawmode)
+	t.closeOnce.Do(func() {
+		t.mutex.Lock()
+		defer t.mutex.Unlock()
+
+		if !t.suspended {
+			t.closeErr = t.restore()
+		}
+
+		t.closed = true
+	})
+	return t.closeErr
+}
+
+func (t *Console) restore() error {
+	fmt.Print(t.exitSequence())
+	return platform.OnClose(t.rawmode)
+}
+
+func (t *Console) exitSequence() string {
+	var sequence strings.Builder
+
+	if t.caps.ExtendedKeys {
+		sequence.WriteString(wrapper_ansi.DisableExtendedKeys)
+	}
+
+	if t.caps.Mouse {
+		sequence.WriteString(wrapper_ansi.DisableMouse)
+	}
+
+	sequence.WriteString(wrapper_ansi.DisablePaste + wrapper_ansi.Reset)
+
+	if t.useAltScreen() {
+		sequence.WriteString(wrapper_ansi.ShowCursor + wrapper_ansi.ExitAltScreen)
+	} else {
+		sequence.WriteString(wrapper_ansi.FullReset + wrapper_ansi.ShowCursor + wrapper_ansi.CursorHome)
+	}
+
+	return sequence.String()
+}
+
+func (t *Console) Capabilities() capability.Capabilities {
+	return t.caps
+}
+
+func (t *Console) useAltScreen() bool {
+	return t.altScreen && t.caps.AltScreen
+}
+
+func (t *Console) queryCapabilities() {
+	in, err := platform.PollableStdin()
+	if err != nil {
+		return
+	}
+	defer platform.ReleaseStdin(in)
+
+	response, err := wrapper_probe.Query(in, os.Stdout, t.query)
+	if err != nil && len(response.Attributes) == 0 {
+		return
+	}
+
+	t.caps = response.Apply(t.caps)
+	capability.Set(t.caps)
+}
+
+func (t *Console) watchTermination() {
+	sig := make(chan os.Signal, 1)
+	signal.Notify(sig, syscall.SIGTERM)
+	defer signal.Stop(sig)
+
+	select {
+	case <-t.context.Done():
+		return
+	case <-sig:
+	}
+
+	t.OnClose()
+
+	// Hands the signal back to the application, or to the default
+	// handler when nothing else is listening for it.
+	signal.Stop(sig)
+	platform.Raise(syscall.SIGTERM)
+}
+
+// Suspend hands the terminal back to the shell in cooked mode and stops
+// the process; the frame is rebuilt once SIGCONT brings it back.
+func (t *Console) Suspend() error {
+	t.mutex.Lock()
+	if t.suspended || t.closed {
+		t.mutex.Unlock()
+		return nil
+	}
+
+	err := t.restore()
+	t.suspended = true
+	t.mutex.Unlock()
+
 	if err != nil {
 		return err
 	}
 
-	fmt.Print(wrapper_ansi.Reset + wrapper_ansi.FullReset + wrapper_ansi.ShowCursor + wrapper_ansi.CursorHome)
+	return platform.Suspend()
+}
+
+func (t *Console) resume() error {
+	t.mutex.Lock()
+	defer t.mutex.Unlock()
+
+	if !t.suspended || t.closed {
+		return nil
+	}
+
+	rawmode, err := platform.OnStart(t.rawopts)
+	if err != nil {
+		return err
+	}
+
+	t.rawmode = rawmode
+	t.suspended = false
+
+	t.prepare()
+
+	size, _ := t.Size()
+
+	select {
+	case <-t.resumeChan:
+	default:
+	}
+
+	t.resumeChan <- size
 
 	return nil
 }
 
+func (t *Console) ResumeEvents() <-chan winsize.Winsize {
+	return t.resumeChan
+}
+
+func (t *Console) watchJobControl() {
+	suspend := notifySignals(platform.SuspendSignals())
+	resume := notifySignals(platform.ResumeSignals())
+
+	go func() {
+		defer stopSignals(suspend)
+		defer stopSignals(resume)
+
+		for {
+			select {
+			case <-t.context.Done():
+				return
+			case <-suspend:
+				if err := t.Suspend(); err != nil {
+					log.Error(err)
+				}
+			case <-resume:
+				if err := t.resume(); err != nil {
+					log.Error(err)
+				}
+			}
+		}
+	}()
+}
+
+func notifySignals(signals []os.Signal) chan os.Signal {
+	if len(signals) == 0 {
+		return nil
+	}
+
+	sig := make(chan os.Signal, 1)
+	signal.Notify(sig, signals...)
+
+	return sig
+}
+
+func stopSignals(sig chan os.Signal) {
+	if sig != nil {
+		signal.Stop(sig)
+	}
+}
+
+func (t *Console) restoreOnPanic() {
+	if r := recover(); r != nil {
+		t.OnClose()
+		panic(r)
+	}
+}
+
 func (t *Console) ResizeEvents() <-chan winsize.Winsize {
 	if t.resizeChan != nil {
 		return t.resizeChan
@@ -87,6 +336,7 @@ func (t *Console) ResizeEvents() <-chan winsize.Winsize {
 }
 
 func (t *Console) listenResizeEvents(source <-chan winsize.Winsize) {
+	defer t.restoreOnPanic()
 	defer close(t.resizeChan)
 
 	for {
@@ -107,29 +357,65 @@ func (t *Console) listenResizeEvents(source <-chan winsize.Winsize) {
 }
 
 func (t *Console) KeyEvents() <-chan key.Key {
+	t.listenInput()
+	return t.keyChan
+}
+
+func (t *Console) MouseEvents() <-chan mouse.Mouse {
+	t.listenInput()
+	return t.mouseChan
+}
+
+func (t *Console) PasteEvents() <-chan string {
+	t.listenInput()
+	return t.pasteChan
+}
+
+func (t *Console) listenInput() {
 	if t.keyChan != nil {
-		return t.keyChan
+		return
 	}
 
 	t.keyChan = make(chan key.Key)
-	go t.listenKeyEvents()
-
-	return t.keyChan
+	t.mouseChan = make(chan mouse.Mouse, mouseBuffer)
+	t.pasteChan = make(chan string)
+	go t.listenInputEvents()
 }
 
-func (t *Console) listenKeyEvents() {
+func (t *Console) listenInputEvents() {
+	defer t.restoreOnPanic()
 	defer close(t.keyChan)
+	defer close(t.mouseChan)
+	defer close(t.pasteChan)
 
 	for {
-		k, err := t.reader.ReadKey()
+		input, err := t.reader.ReadInput()
 		if err != nil {
 			return
 		}
 
-		select {
-		case <-t.context.Done():
-			return
-		case t.keyChan <- *k:
+		switch input.Kind {
+		case wrapper_reader.InputMouse:
+			select {
+			case <-t.context.Done():
+				return
+			case t.mouseChan <- input.Mouse:
+			default:
+			}
+
+		case wrapper_reader.InputPaste:
+			select {
+			case <-t.context.Done():
+				return
+			case t.pasteChan <- input.Paste:
+			}
+
+		default:
+			select {
+			case <-t.context.Done():
+				return
+			case t.keyChan <- input.Key:
+			}
 		}
 	}
 }
@@ -144,7 +430,17 @@ func (t *Console) Size() (winsize.Winsize, error) {
 }
 
 func (t *Console) Clear() error {
+	t.mutex.Lock()
+	defer t.mutex.Unlock()
+
+	if t.closed {
+		return nil
+	}
+
 	fmt.Print(wrapper_ansi.CursorHome)
+	if t.screen != nil {
+		t.screen.Home()
+	}
 	return nil
 }
 
@@ -162,7 +458,30 @@ func (t *Console) WriteAll(text string) error {
 }
 
 func (t *Console) Flush() error {
-	fmt.Print(t.buffer.join("\n"))
+	t.mutex.Lock()
+	defer t.mutex.Unlock()
+
+	// Once closed the terminal belongs to the user again, a frame the
+	// engine still renders must not land on their screen.
+	if t.suspended || t.closed {
+		t.buffer.clear()
+		return nil
+	}
+
+	fmt.Print(t.render())
 	t.buffer.clear()
 	return nil
 }
+
+// render turns the buffer into the output of a frame. Colour resets go
+// back to the console colour rather than to the terminal default.
+func (t *Console) render() string {
+	if t.screen != nil {
+		return t.screen.Update(t.buffer.lines, t.buffer.size)
+	}
+
+	return wrapper_grid.RestoreColors(
+		t.buffer.join(lineBreak),
+		wrapper_grid.ParseSequence(t.color),
+	)
+}
@@ -0,0 +1,129 @@
+package wrapper_console
+
+import (
+	"io"
+	"os"
+	"strings"
+	"testing"
+
+	assert "github.com/Rafael24595/go-assert/assert/test"
+
+	"github.com/Rafael24595/go-reacterm-core/engine/model/winsize"
+	"github.com/Rafael24595/go-reacterm-core/engine/render/processor"
+	"github.com/Rafael24595/go-reacterm-core/engine/render/style"
+	"github.com/Rafael24595/go-reacterm-core/engine/render/styler"
+	"github.com/Rafael24595/go-reacterm-core/engine/render/text"
+	"github.com/Rafael24595/go-reacterm-core/engine/terminal/capability"
+
+	wrapper_ansi "github.com/Rafael24595/go-reacterm-core/wrapper/ansi"
+	wrapper_render "github.com/Rafael24595/go-reacterm-core/wrapper/render"
+	wrapper_grid "github.com/Rafael24595/go-reacterm-core/wrapper/terminal/grid"
+)
+
+func coloredLine() string {
+	atom := styler.NewDefaultAtom().Push(wrapper_render.Atoms.ToPairsSlice()...)
+	standard := processor.New(*atom, *styler.NewDefaultSpec()).
+		Color(*wrapper_render.Colors)
+
+	line := text.LineFromFragments(
+		*text.NewFragment("a").SetFg(style.Ansi(style.Red)),
+		*text.NewFragment("b"),
+		*text.NewFragment("c").AddAtom(style.AtmBold),
+	)
+
+	return standard.Render([]text.Line{*line}, winsize.New(1, 3))[0]
+}
+
+func cellStyles(output string) []string {
+	cells := wrapper_grid.ParseLine(output, 3, wrapper_grid.Style{})
+
+	styles := make([]string, len(cells))
+	for i, cell := range cells {
+		styles[i] = cell.Style.Sequence()
+	}
+	return styles
+}
+
+func TestConsole_ColorSurvivesFragments(t *testing.T) {
+	for _, full := range []bool{false, true} {
+		builder := NewBuilder().
+			Capabilities(capability.Full()).
+			Color("\x1b[0;32m")
+		if full {
+			builder.FullRedraw()
+		}
+
+		console := builder.Build()
+		console.buffer.defineSize(winsize.New(1, 3))
+		console.Write(coloredLine())
+
+		styles := cellStyles(console.color + console.render())
+
+		assert.Equal(t, "\x1b[0;31m", styles[0])
+		assert.Equal(t, "\x1b[0;32m", styles[1])
+		assert.Equal(t, "\x1b[0;1;32m", styles[2])
+	}
+}
+
+func TestConsole_ExtendedKeys(t *testing.T) {
+	caps := capability.Full()
+	caps.ExtendedKeys = true
+
+	console := NewBuilder().
+		Capabilities(caps).
+		Build()
+
+	enter := console.enterSequence()
+	exit := console.exitSequence()
+
+	assert.True(t, strings.HasSuffix(enter, wrapper_ansi.EnableExtendedKeys))
+	assert.True(t, strings.HasPrefix(exit, wrapper_ansi.DisableExtendedKeys))
+}
+
+func TestConsole_ExtendedKeys_OffByDefault(t *testing.T) {
+	console := NewBuilder().Build()
+
+	assert.False(t, strings.Contains(console.enterSequence(), wrapper_ansi.EnableExtendedKeys))
+	assert.False(t, strings.Contains(console.exitSequence(), wrapper_ansi.DisableExtendedKeys))
+}
+
+func captureStdout(t *testing.T, action func()) string {
+	reader, writer, err := os.Pipe()
+	assert.Nil(t, err)
+
+	stdout := os.Stdout
+	os.Stdout = writer
+	defer func() {
+		os.Stdout = stdout
+	}()
+
+	action()
+	writer.Close()
+
+	output, err := io.ReadAll(reader)
+	assert.Nil(t, err)
+
+	return string(output)
+}
+
+func TestConsole_SilentOnceClosed(t *testing.T) {
+	console := NewBuilder().
+		Capabilities(capability.Full()).
+		Build()
+	console.buffer.defineSize(winsize.New(1, 3))
+
+	output := captureStdout(t, func() {
+		console.WriteAll("abc")
+		console.Flush()
+	})
+	assert.True(t, strings.Contains(output, "abc"))
+
+	console.closed = true
+
+	output = captureStdout(t, func() {
+		console.WriteAll("abc")
+		console.Flush()
+		console.Clear()
+	})
+	assert.Equal(t, "", output)
+}
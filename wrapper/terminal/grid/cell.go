@@ -0,0 +1,150 @@
+package wrapper_grid
+
+import (
+	"strings"
+
+	"github.com/Rafael24595/go-reacterm-core/engine/helper/runes"
+	"github.com/Rafael24595/go-reacterm-core/engine/model/ascii"
+	"github.com/Rafael24595/go-reacterm-core/engine/model/winsize"
+)
+
+const (
+	csiIntroducer = '['
+	oscIntroducer = ']'
+	sgrFinal      = 'm'
+	oscHyperlink  = "8"
+)
+
+// Cell is one terminal column. A wide grapheme cluster lives in its
+// first cell and leaves the following ones as continuations with no text.
+type Cell struct {
+	Text  string
+	Width uint8
+	Style Style
+	Link  string
+}
+
+func BlankCell(style Style) Cell {
+	return Cell{
+		Text:  string(rune(ascii.SPACE)),
+		Width: 1,
+		Style: style,
+	}
+}
+
+func continuationCell(style Style) Cell {
+	return Cell{
+		Text:  "",
+		Width: 0,
+		Style: style,
+	}
+}
+
+func (c Cell) IsContinuation() bool {
+	return c.Width == 0
+}
+
+func ParseLine(line string, cols winsize.Cols, base Style) []Cell {
+	cells := make([]Cell, 0, cols)
+	style := base
+	link := ""
+	full := false
+
+	rns := []rune(line)
+	for i := 0; i < len(rns); i++ {
+		rn := rns[i]
+
+		if rn == ascii.ESC {
+			sequence, final, next := readSequence(rns, i)
+			switch final {
+			case sgrFinal:
+				style = style.ApplyOver(sequence, base)
+			case oscIntroducer:
+				link = parseHyperlink(sequence, link)
+			}
+			i = next
+			continue
+		}
+
+		if rn < ascii.SPACE || rn == ascii.DEL {
+			continue
+		}
+
+		end := runes.NextGrapheme(rns, i)
+		cluster := rns[i:end]
+		i = end - 1
+
+		width := runes.GraphemeWidth(cluster)
+		if width == 0 {
+			continue
+		}
+
+		if full || winsize.Cols(len(cells)+width) > cols {
+			full = true
+			continue
+		}
+
+		cells = append(cells, Cell{
+			Text:  string(cluster),
+			Width: uint8(width),
+			Style: style,
+			Link:  link,
+		})
+
+		for range width - 1 {
+			cell := continuationCell(style)
+			cell.Link = link
+			cells = append(cells, cell)
+		}
+	}
+
+	for winsize.Cols(len(cells)) < cols {
+		cells = append(cells, BlankCell(base))
+	}
+
+	return cells
+}
+
+func readSequence(rns []rune, start int) (string, rune, int) {
+	if start+1 < len(rns) && rns[start+1] == oscIntroducer {
+		return readOperatingCommand(rns, start)
+	}
+
+	if start+1 >= len(rns) || rns[start+1] != csiIntroducer {
+		return "", 0, min(start+1, len(rns)-1)
+	}
+
+	for i := start + 2; i < len(rns); i++ {
+		rn := rns[i]
+		if rn >= '@' && rn <= '~' {
+			return string(rns[start+2 : i]), rn, i
+		}
+	}
+
+	return "", 0, len(rns) - 1
+}
+
+// readOperatingCommand reads an OSC payload, terminated either by BEL or
+// by the ESC \\ string terminator.
+func readOperatingCommand(rns []rune, start int) (string, rune, int) {
+	for i := start + 2; i < len(rns); i++ {
+		switch {
+		case rns[i] == ascii.BEL:
+			return string(rns[start+2 : i]), oscIntroducer, i
+		case rns[i] == ascii.ESC && i+1 < len(rns) && rns[i+1] == '\\':
+			return string(rns[start+2 : i]), oscIntroducer, i + 1
+		}
+	}
+
+	return "", 0, len(rns) - 1
+}
+
+// parseHyperlink returns the link opened by an OSC 8 payload, an empty
+// string when the payload closes it, or current for any other command.
+func parseHyperlink(payload, current string) string {
+	parts := strings.SplitN(payload, ";", 3)
+	if len(parts) != 3 || parts[0] != oscHyperlink {
+		return current
+	}
+	return parts[2]
+}
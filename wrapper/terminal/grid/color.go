@@ -0,0 +1,149 @@
+package wrapper_grid
+
+import (
+	"strconv"
+	"strings"
+
+	"github.com/Rafael24595/go-reacterm-core/engine/terminal/capability"
+)
+
+type rgb [3]int
+
+var palette16 = []rgb{
+	{0, 0, 0}, {205, 0, 0}, {0, 205, 0}, {205, 205, 0},
+	{0, 0, 238}, {205, 0, 205}, {0, 205, 205}, {229, 229, 229},
+	{127, 127, 127}, {255, 0, 0}, {0, 255, 0}, {255, 255, 0},
+	{92, 92, 255}, {255, 0, 255}, {0, 255, 255}, {255, 255, 255},
+}
+
+var cubeLevels = []int{0, 95, 135, 175, 215, 255}
+
+func (s Style) Degrade(depth capability.ColorDepth) Style {
+	if depth == capability.ColorTrue {
+		return s
+	}
+
+	s.codes[slotForeground] = degradeColor(s.codes[slotForeground], depth, 30, 90)
+	s.codes[slotBackground] = degradeColor(s.codes[slotBackground], depth, 40, 100)
+
+	return s
+}
+
+func degradeColor(code string, depth capability.ColorDepth, normal, bright int) string {
+	if code == "" {
+		return code
+	}
+
+	if depth == capability.ColorNone {
+		return ""
+	}
+
+	params := strings.Split(code, ";")
+	if len(params) < 3 {
+		return code
+	}
+
+	values := make([]int, len(params))
+	for i, p := range params {
+		value, err := strconv.Atoi(p)
+		if err != nil {
+			return code
+		}
+		values[i] = value
+	}
+
+	color, ok := rgbFromParams(values)
+	if !ok {
+		return code
+	}
+
+	if depth == capability.Color256 {
+		if values[1] == 5 {
+			return code
+		}
+		return params[0] + ";5;" + strconv.Itoa(rgbTo256(color))
+	}
+
+	index := rgbTo16(color)
+	if index < 8 {
+		return strconv.Itoa(normal + index)
+	}
+	return strconv.Itoa(bright + index - 8)
+}
+
+func rgbFromParams(values []int) (rgb, bool) {
+	switch {
+	case values[1] == 5 && len(values) == 3:
+		return rgbFrom256(values[2]), true
+	case values[1] == 2 && len(values) == 5:
+		return rgb{values[2], values[3], values[4]}, true
+	}
+	return rgb{}, false
+}
+
+func rgbFrom256(index int) rgb {
+	switch {
+	case index < 16:
+		return palette16[max(0, index)]
+	case index < 232:
+		index -= 16
+		return rgb{cubeLevels[index/36], cubeLevels[(index/6)%6], cubeLevels[index%6]}
+	}
+
+	gray := 8 + 10*(min(index, 255)-232)
+	return rgb{gray, gray, gray}
+}
+
+func rgbTo256(color rgb) int {
+	cube := [3]int{}
+	for i, c := range color {
+		cube[i] = nearestLevel(c)
+	}
+
+	cubeIndex := 16 + 36*cube[0] + 6*cube[1] + cube[2]
+
+	average := (color[0] + color[1] + color[2]) / 3
+	grayIndex := 232 + min(23, max(0, (average-3)/10))
+
+	if distance(color, rgbFrom256(grayIndex)) < distance(color, rgbFrom256(cubeIndex)) {
+		return grayIndex
+	}
+
+	return cubeIndex
+}
+
+func rgbTo16(color rgb) int {
+	best, bestDistance := 0, -1
+	for i, p := range palette16 {
+		d := distance(color, p)
+		if bestDistance < 0 || d < bestDistance {
+			best, bestDistance = i, d
+		}
+	}
+	return best
+}
+
+func nearestLevel(value int) int {
+	best := 0
+	for i, level := range cubeLevels {
+		if abs(value-level) < abs(value-cubeLevels[best]) {
+			best = i
+		}
+	}
+	return best
+}
+
+func distance(a, b rgb) int {
+	d := 0
+	for i := range a {
+		d += (a[i] - b[i]) * (a[i] - b[i])
+	}
+	return d
+}
+
+func abs(value int) int {
+	if value < 0 {
+		return -value
+	}
+	return value
+}
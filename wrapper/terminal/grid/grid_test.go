@@ -0,0 +1,192 @@
+package wrapper_grid
+
+import (
+	"strings"
+	"testing"
+
+	assert "github.com/Rafael24595/go-assert/assert/test"
+
+	"github.com/Rafael24595/go-reacterm-core/engine/model/winsize"
+	"github.com/Rafael24595/go-reacterm-core/engine/terminal/capability"
+
+	wrapper_ansi "github.com/Rafael24595/go-reacterm-core/wrapper/ansi"
+)
+
+func TestParseStyle(t *testing.T) {
+	style := ParseStyle("1;38;5;208;44")
+
+	assert.Equal(t, "\x1b[0;1;38;5;208;44m", style.Sequence())
+
+	style = style.Apply("22;49")
+
+	assert.Equal(t, "\x1b[0;38;5;208m", style.Sequence())
+	assert.True(t, style.Apply("0").Empty())
+}
+
+func TestParseLine(t *testing.T) {
+	line := wrapper_ansi.ClearLine + "a" + wrapper_ansi.Bold + "b" + wrapper_ansi.NormalWeight + "c"
+
+	cells := ParseLine(line, 5, Style{})
+
+	assert.Len(t, 5, cells)
+	assert.Equal(t, "a", cells[0].Text)
+	assert.True(t, cells[0].Style.Empty())
+	assert.Equal(t, "b", cells[1].Text)
+	assert.Equal(t, "\x1b[0;1m", cells[1].Style.Sequence())
+	assert.True(t, cells[2].Style.Empty())
+	assert.Equal(t, " ", cells[4].Text)
+}
+
+func TestParseLine_Truncate(t *testing.T) {
+	cells := ParseLine("abcdef", 3, Style{})
+
+	assert.Len(t, 3, cells)
+	assert.Equal(t, "c", cells[2].Text)
+}
+
+func TestParseLine_WideClusters(t *testing.T) {
+	cells := ParseLine("山e\u0301🇯🇵x", 6, Style{})
+
+	assert.Len(t, 6, cells)
+	assert.Equal(t, "山", cells[0].Text)
+	assert.True(t, cells[1].IsContinuation())
+	assert.Equal(t, "e\u0301", cells[2].Text)
+	assert.Equal(t, "🇯🇵", cells[3].Text)
+	assert.True(t, cells[4].IsContinuation())
+	assert.Equal(t, "x", cells[5].Text)
+}
+
+func TestParseLine_WideDoesNotSplit(t *testing.T) {
+	cells := ParseLine("ab山", 3, Style{})
+
+	assert.Len(t, 3, cells)
+	assert.Equal(t, " ", cells[2].Text)
+	assert.Equal(t, uint8(1), cells[2].Width)
+}
+
+func TestScreen_WideCellChange(t *testing.T) {
+	screen := New("")
+	size := winsize.New(1, 4)
+
+	screen.Update([]string{"山田"}, size)
+	output := screen.Update([]string{"山x"}, size)
+
+	assert.True(t, strings.Contains(output, "x"))
+	assert.False(t, strings.Contains(output, "山"))
+}
+
+func TestScreen_FirstUpdateRedraws(t *testing.T) {
+	screen := New("")
+	size := winsize.New(2, 4)
+
+	output := screen.Update([]string{"ab"}, size)
+
+	assert.True(t, strings.Contains(output, wrapper_ansi.FullReset))
+	assert.True(t, strings.Contains(output, "ab"))
+}
+
+func TestScreen_UnchangedFrameIsEmpty(t *testing.T) {
+	screen := New("")
+	size := winsize.New(2, 4)
+
+	screen.Update([]string{"ab", "cd"}, size)
+	output := screen.Update([]string{"ab", "cd"}, size)
+
+	assert.Equal(t, "", output)
+}
+
+func TestScreen_MinimalUpdate(t *testing.T) {
+	screen := New("")
+	size := winsize.New(2, 10)
+
+	screen.Update([]string{"hello", "world"}, size)
+	output := screen.Update([]string{"hello", "wOrld"}, size)
+
+	assert.Equal(t, wrapper_ansi.CursorTo(2, 2)+"O", output)
+}
+
+func TestScreen_StyleChange(t *testing.T) {
+	screen := New("")
+	size := winsize.New(1, 10)
+
+	screen.Update([]string{"abc"}, size)
+	output := screen.Update([]string{"a" + wrapper_ansi.Reverse + "b" + wrapper_ansi.NoReverse + "c"}, size)
+
+	assert.Equal(t, wrapper_ansi.CursorTo(1, 2)+"\x1b[0;7mb", output)
+}
+
+func TestScreen_ReprintShortGap(t *testing.T) {
+	screen := New("")
+	size := winsize.New(1, 10)
+
+	screen.Update([]string{"abcdef"}, size)
+	output := screen.Update([]string{"XbcYef"}, size)
+
+	assert.Equal(t, wrapper_ansi.CursorTo(1, 1)+"XbcY", output)
+}
+
+func TestParseLine_Hyperlink(t *testing.T) {
+	line := "a" + wrapper_ansi.Hyperlink("https://x.io") + "bc" + wrapper_ansi.HyperlinkEnd + "d"
+	cells := ParseLine(line, 5, Style{})
+
+	assert.Equal(t, "a", cells[0].Text)
+	assert.Equal(t, "", cells[0].Link)
+	assert.Equal(t, "b", cells[1].Text)
+	assert.Equal(t, "https://x.io", cells[1].Link)
+	assert.Equal(t, "https://x.io", cells[2].Link)
+	assert.Equal(t, "d", cells[3].Text)
+	assert.Equal(t, "", cells[3].Link)
+}
+
+func TestParseLine_HyperlinkBellTerminator(t *testing.T) {
+	line := "\x1b]8;;https://x.io\x07ab\x1b]8;;\x07c"
+	cells := ParseLine(line, 3, Style{})
+
+	assert.Equal(t, "a", cells[0].Text)
+	assert.Equal(t, "https://x.io", cells[0].Link)
+	assert.Equal(t, "c", cells[2].Text)
+	assert.Equal(t, "", cells[2].Link)
+}
+
+func TestScreen_HyperlinkIsClosed(t *testing.T) {
+	screen := New("")
+	size := winsize.New(1, 10)
+
+	screen.Update([]string{"abc"}, size)
+	output := screen.Update([]string{"a" + wrapper_ansi.Hyperlink("https://x.io") + "b" + wrapper_ansi.HyperlinkEnd + "c"}, size)
+
+	expected := wrapper_ansi.CursorTo(1, 2) + wrapper_ansi.Hyperlink("https://x.io") + "b" + wrapper_ansi.HyperlinkEnd
+	assert.Equal(t, expected, output)
+}
+
+func TestScreen_ResizeRedraws(t *testing.T) {
+	screen := New("\x1b[0;32m")
+
+	screen.Update([]string{"ab"}, winsize.New(1, 4))
+	output := screen.Update([]string{"ab"}, winsize.New(1, 6))
+
+	assert.True(t, strings.HasPrefix(output, "\x1b[0;32m"+wrapper_ansi.FullReset))
+}
+
+func TestStyle_Degrade(t *testing.T) {
+	style := ParseStyle("38;2;255;0;0;48;5;21")
+
+	assert.Equal(t, "\x1b[0;38;2;255;0;0;48;5;21m", style.Degrade(capability.ColorTrue).Sequence())
+	assert.Equal(t, "\x1b[0;38;5;196;48;5;21m", style.Degrade(capability.Color256).Sequence())
+	assert.Equal(t, "\x1b[0;91;44m", style.Degrade(capability.Color16).Sequence())
+	assert.Equal(t, "\x1b[0m", style.Degrade(capability.ColorNone).Sequence())
+}
+
+func TestParseSequence(t *testing.T) {
+	style := ParseSequence("\x1b[0;32m\x1b[1m")
+
+	assert.Equal(t, "\x1b[0;1;32m", style.Sequence())
+}
+
+func TestParseStyle_Attributes(t *testing.T) {
+	style := ParseStyle("1;2;4:3;9;53")
+
+	assert.Equal(t, "\x1b[0;1;2;4:3;9;53m", style.Sequence())
+	assert.Equal(t, "\x1b[0;9;53m", style.Apply("22;4:0").Sequence())
+	assert.Equal(t, "\x1b[0;1;2;9;53m", style.Apply("24").Sequence())
+}
@@ -0,0 +1,195 @@
+package wrapper_grid
+
+import (
+	"strings"
+
+	"github.com/Rafael24595/go-reacterm-core/engine/model/winsize"
+	"github.com/Rafael24595/go-reacterm-core/engine/terminal/capability"
+
+	wrapper_ansi "github.com/Rafael24595/go-reacterm-core/wrapper/ansi"
+)
+
+const jumpThreshold = 4
+
+type position struct {
+	row   int
+	col   int
+	known bool
+}
+
+type Screen struct {
+	base   Style
+	depth  capability.ColorDepth
+	size   winsize.Winsize
+	cells  [][]Cell
+	pen    Style
+	link   string
+	cursor position
+	valid  bool
+}
+
+func New(base string) *Screen {
+	style := ParseSequence(base)
+	return &Screen{
+		base:   style,
+		depth:  capability.ColorTrue,
+		size:   winsize.Winsize{},
+		cells:  make([][]Cell, 0),
+		pen:    style,
+		link:   "",
+		cursor: position{},
+		valid:  false,
+	}
+}
+
+func (s *Screen) Depth(depth capability.ColorDepth) *Screen {
+	s.depth = depth
+	s.base = s.base.Degrade(depth)
+	s.valid = false
+	return s
+}
+
+func (s *Screen) Invalidate() *Screen {
+	s.valid = false
+	return s
+}
+
+func (s *Screen) Home() *Screen {
+	s.cursor = position{row: 0, col: 0, known: true}
+	return s
+}
+
+func (s *Screen) Update(lines []string, size winsize.Winsize) string {
+	var buffer strings.Builder
+
+	if !s.valid || !s.size.Eq(size) {
+		s.reset(&buffer, size)
+	}
+
+	for row := 0; row < int(size.Rows); row++ {
+		line := ""
+		if row < len(lines) {
+			line = lines[row]
+		}
+
+		next := ParseLine(line, size.Cols, s.base)
+		for i := range next {
+			next[i].Style = next[i].Style.Degrade(s.depth)
+		}
+
+		s.updateRow(&buffer, row, next)
+	}
+
+	s.openLink(&buffer, "")
+
+	return buffer.String()
+}
+
+func (s *Screen) reset(buffer *strings.Builder, size winsize.Winsize) {
+	s.size = size
+	s.cells = make([][]Cell, size.Rows)
+	for i := range s.cells {
+		s.cells[i] = ParseLine("", size.Cols, s.base)
+	}
+
+	buffer.WriteString(s.base.Sequence())
+	buffer.WriteString(wrapper_ansi.FullReset)
+
+	s.pen = s.base
+	s.link = ""
+	s.cursor = position{row: 0, col: 0, known: true}
+	s.valid = true
+}
+
+func (s *Screen) updateRow(buffer *strings.Builder, row int, next []Cell) {
+	prev := s.cells[row]
+
+	for col := 0; col < len(next); col++ {
+		if prev[col] == next[col] {
+			continue
+		}
+
+		for col > 0 && next[col].IsContinuation() {
+			col--
+		}
+
+		s.moveTo(buffer, row, col, next)
+
+		if s.pen != next[col].Style {
+			buffer.WriteString(next[col].Style.Sequence())
+			s.pen = next[col].Style
+		}
+
+		s.openLink(buffer, next[col].Link)
+
+		s.write(buffer, next[col])
+		col += int(next[col].Width) - 1
+	}
+
+	s.cells[row] = next
+}
+
+func (s *Screen) moveTo(buffer *strings.Builder, row, col int, next []Cell) {
+	if s.cursor.known && s.cursor.row == row && s.cursor.col == col {
+		return
+	}
+
+	if s.canReprint(row, col, next) {
+		for c := s.cursor.col; c < col; c += int(next[c].Width) {
+			s.write(buffer, next[c])
+		}
+		return
+	}
+
+	buffer.WriteString(wrapper_ansi.CursorTo(row+1, col+1))
+	s.cursor = position{row: row, col: col, known: true}
+}
+
+func (s *Screen) canReprint(row, col int, next []Cell) bool {
+	if !s.cursor.known || s.cursor.row != row || s.cursor.col > col {
+		return false
+	}
+
+	if col-s.cursor.col > jumpThreshold {
+		return false
+	}
+
+	if s.cursor.col < col && next[s.cursor.col].IsContinuation() {
+		return false
+	}
+
+	for c := s.cursor.col; c < col; c++ {
+		if next[c].Style != s.pen || next[c].Link != s.link {
+			return false
+		}
+	}
+
+	return true
+}
+
+// openLink switches the active hyperlink; an empty link closes it.
+func (s *Screen) openLink(buffer *strings.Builder, link string) {
+	if s.link == link {
+		return
+	}
+
+	if link == "" {
+		buffer.WriteString(wrapper_ansi.HyperlinkEnd)
+	} else {
+		buffer.WriteString(wrapper_ansi.Hyperlink(link))
+	}
+
+	s.link = link
+}
+
+func (s *Screen) write(buffer *strings.Builder, cell Cell) {
+	buffer.WriteString(cell.Text)
+	s.advance(int(cell.Width))
+}
+
+func (s *Screen) advance(width int) {
+	s.cursor.col += width
+	if s.cursor.col >= int(s.size.Cols) {
+		s.cursor.known = false
+	}
+}
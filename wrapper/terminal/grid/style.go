@@ -0,0 +1,199 @@
+package wrapper_grid
+
+import (
+	"strconv"
+	"strings"
+
+	"github.com/Rafael24595/go-reacterm-core/engine/model/ascii"
+)
+
+type slot uint8
+
+const (
+	slotBold slot = iota
+	slotDim
+	slotItalic
+	slotUnderline
+	slotBlink
+	slotReverse
+	slotHidden
+	slotStrike
+	slotOverline
+	slotForeground
+	slotBackground
+	slotCount
+)
+
+// Styled underlines travel as colon sub-parameters, e.g. 4:3 for curly.
+const (
+	underlinePrefix = "4:"
+	underlineOff    = "0"
+)
+
+type Style struct {
+	codes [slotCount]string
+}
+
+func ParseStyle(sequence string) Style {
+	return Style{}.Apply(sequence)
+}
+
+func ParseSequence(sequence string) Style {
+	style := Style{}
+
+	rns := []rune(sequence)
+	for i := 0; i < len(rns); i++ {
+		if rns[i] != ascii.ESC {
+			continue
+		}
+
+		params, final, next := readSequence(rns, i)
+		if final == sgrFinal {
+			style = style.Apply(params)
+		}
+		i = next
+	}
+
+	return style
+}
+
+func (s Style) Empty() bool {
+	return s == Style{}
+}
+
+func (s Style) Apply(sequence string) Style {
+	return s.ApplyOver(sequence, Style{})
+}
+
+// ApplyOver applies the sequence to text drawn over base, so resetting
+// the foreground or background returns to the base colour rather than
+// to the terminal default.
+func (s Style) ApplyOver(sequence string, base Style) Style {
+	params := strings.Split(sequence, ";")
+
+	for i := 0; i < len(params); i++ {
+		if sub, ok := strings.CutPrefix(params[i], underlinePrefix); ok {
+			s.codes[slotUnderline] = params[i]
+			if sub == underlineOff {
+				s.codes[slotUnderline] = ""
+			}
+			continue
+		}
+
+		code, err := strconv.Atoi(params[i])
+		if err != nil {
+			if params[i] == "" {
+				s = Style{}
+			}
+			continue
+		}
+
+		switch {
+		case code == 0:
+			s = Style{}
+		case code == 1:
+			s.codes[slotBold] = params[i]
+		case code == 2:
+			s.codes[slotDim] = params[i]
+		case code == 22:
+			s.codes[slotBold] = ""
+			s.codes[slotDim] = ""
+		case code == 3:
+			s.codes[slotItalic] = params[i]
+		case code == 23:
+			s.codes[slotItalic] = ""
+		case code == 4 || code == 21:
+			s.codes[slotUnderline] = params[i]
+		case code == 24:
+			s.codes[slotUnderline] = ""
+		case code == 5 || code == 6:
+			s.codes[slotBlink] = params[i]
+		case code == 25:
+			s.codes[slotBlink] = ""
+		case code == 7:
+			s.codes[slotReverse] = params[i]
+		case code == 27:
+			s.codes[slotReverse] = ""
+		case code == 8:
+			s.codes[slotHidden] = params[i]
+		case code == 28:
+			s.codes[slotHidden] = ""
+		case code == 9:
+			s.codes[slotStrike] = params[i]
+		case code == 29:
+			s.codes[slotStrike] = ""
+		case code == 53:
+			s.codes[slotOverline] = params[i]
+		case code == 55:
+			s.codes[slotOverline] = ""
+		case code == 38 || code == 48:
+			color, next := extendedColor(params, i)
+			if code == 38 {
+				s.codes[slotForeground] = color
+			} else {
+				s.codes[slotBackground] = color
+			}
+			i = next
+		case code == 39:
+			s.codes[slotForeground] = base.codes[slotForeground]
+		case code == 49:
+			s.codes[slotBackground] = base.codes[slotBackground]
+		case code >= 30 && code <= 37, code >= 90 && code <= 97:
+			s.codes[slotForeground] = params[i]
+		case code >= 40 && code <= 47, code >= 100 && code <= 107:
+			s.codes[slotBackground] = params[i]
+		}
+	}
+
+	return s
+}
+
+func (s Style) Sequence() string {
+	params := make([]string, 0, slotCount+1)
+	params = append(params, "0")
+
+	for _, code := range s.codes {
+		if code != "" {
+			params = append(params, code)
+		}
+	}
+
+	return "\x1b[" + strings.Join(params, ";") + "m"
+}
+
+// RestoreColors rewrites the default colour resets of a line drawn
+// straight to the terminal into the colours of base.
+func RestoreColors(line string, base Style) string {
+	fg := base.codes[slotForeground]
+	bg := base.codes[slotBackground]
+	if fg == "" && bg == "" {
+		return line
+	}
+
+	pairs := make([]string, 0, 4)
+	if fg != "" {
+		pairs = append(pairs, "\x1b[39m", "\x1b["+fg+"m")
+	}
+	if bg != "" {
+		pairs = append(pairs, "\x1b[49m", "\x1b["+bg+"m")
+	}
+
+	return strings.NewReplacer(pairs...).Replace(line)
+}
+
+func extendedColor(params []string, i int) (string, int) {
+	if i+1 >= len(params) {
+		return params[i], i
+	}
+
+	switch params[i+1] {
+	case "5":
+		end := min(i+3, len(params))
+		return strings.Join(params[i:end], ";"), end - 1
+	case "2":
+		end := min(i+5, len(params))
+		return strings.Join(params[i:end], ";"), end - 1
+	}
+
+	return params[i], i
+}
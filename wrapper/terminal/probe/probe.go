@@ -0,0 +1,158 @@
+package wrapper_probe
+
+import (
+	"os"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/Rafael24595/go-reacterm-core/engine/terminal/capability"
+)
+
+var legacyTerms = []string{
+	"dumb", "linux", "ansi", "cons", "vt1", "vt2", "vt3", "vt5",
+}
+
+var underlineTerms = []string{
+	"kitty", "wezterm", "foot", "alacritty", "contour", "xterm-ghostty",
+}
+
+var hyperlinkTerms = []string{
+	"kitty", "xterm-kitty", "wezterm", "foot", "alacritty", "contour", "xterm-ghostty",
+}
+
+var hyperlinkPrograms = []string{
+	"iTerm.app", "WezTerm", "vscode", "ghostty", "Hyper",
+}
+
+// vteHyperlinks is the first VTE_VERSION, 0.50, that understands OSC 8.
+const vteHyperlinks = 5000
+
+// keyTerms report modified keys like ctrl+shift+c once asked to, through
+// the kitty keyboard protocol or xterm modifyOtherKeys.
+var keyTerms = []string{
+	"xterm", "tmux", "kitty", "foot", "wezterm", "alacritty", "contour", "xterm-ghostty",
+}
+
+var mouseTerms = []string{
+	"xterm", "screen", "tmux", "rxvt", "alacritty", "kitty", "foot", "wezterm", "st-", "konsole", "gnome", "vte",
+}
+
+// altScreenTerms switch to the alternate screen on smcup. GNU screen is
+// left out, it keeps altscreen off unless told otherwise.
+var altScreenTerms = []string{
+	"xterm", "tmux", "rxvt", "alacritty", "kitty", "foot", "wezterm", "st-", "konsole", "gnome", "vte", "contour",
+}
+
+func Detect() capability.Capabilities {
+	return DetectFrom(os.Getenv)
+}
+
+func DetectFrom(env func(string) string) capability.Capabilities {
+	term := env("TERM")
+
+	caps := capability.Capabilities{
+		Name:            term,
+		Version:         "",
+		Color:           detectColor(env, term),
+		Unicode:         detectUnicode(env, term),
+		Mouse:           hasAnyPrefix(term, mouseTerms...),
+		AltScreen:       hasAnyPrefix(term, altScreenTerms...),
+		StyledUnderline: hasAnyPrefix(term, underlineTerms...),
+		Hyperlinks:      detectHyperlinks(env, term),
+		ExtendedKeys:    hasAnyPrefix(term, keyTerms...),
+	}
+
+	info, err := LoadTerminfo(env, term)
+	if err != nil {
+		return caps
+	}
+
+	caps.Mouse = caps.Mouse || info.Mouse
+	caps.AltScreen = caps.AltScreen || info.AltScreen
+
+	if env("NO_COLOR") == "" && caps.Color < ColorFromTerminfo(info.Colors) {
+		caps.Color = ColorFromTerminfo(info.Colors)
+	}
+
+	return caps
+}
+
+func ColorFromTerminfo(colors int) capability.ColorDepth {
+	switch {
+	case colors >= 1<<24:
+		return capability.ColorTrue
+	case colors >= 256:
+		return capability.Color256
+	case colors >= 8:
+		return capability.Color16
+	}
+	return capability.ColorNone
+}
+
+func detectColor(env func(string) string, term string) capability.ColorDepth {
+	if env("NO_COLOR") != "" || term == "" || term == "dumb" {
+		return capability.ColorNone
+	}
+
+	colorterm := strings.ToLower(env("COLORTERM"))
+	if colorterm == "truecolor" || colorterm == "24bit" {
+		return capability.ColorTrue
+	}
+
+	if strings.Contains(term, "direct") || strings.Contains(term, "truecolor") {
+		return capability.ColorTrue
+	}
+
+	if strings.Contains(term, "256") {
+		return capability.Color256
+	}
+
+	return capability.Color16
+}
+
+func detectHyperlinks(env func(string) string, term string) bool {
+	if term == "" || hasAnyPrefix(term, legacyTerms...) {
+		return false
+	}
+
+	if hasAnyPrefix(term, hyperlinkTerms...) {
+		return true
+	}
+
+	if slices.Contains(hyperlinkPrograms, env("TERM_PROGRAM")) {
+		return true
+	}
+
+	if version, err := strconv.Atoi(env("VTE_VERSION")); err == nil && version >= vteHyperlinks {
+		return true
+	}
+
+	return env("WT_SESSION") != "" || env("KONSOLE_VERSION") != ""
+}
+
+func detectUnicode(env func(string) string, term string) bool {
+	if hasAnyPrefix(term, legacyTerms...) {
+		return false
+	}
+
+	locale := env("LC_ALL")
+	if locale == "" {
+		locale = env("LC_CTYPE")
+	}
+	if locale == "" {
+		locale = env("LANG")
+	}
+
+	locale = strings.ToLower(locale)
+	return strings.Contains(locale, "utf-8") || strings.Contains(locale, "utf8")
+}
+
+func hasAnyPrefix(value string, prefixes ...string) bool {
+	for _, p := range prefixes {
+		if strings.HasPrefix(value, p) {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,233 @@
+package wrapper_probe
+
+import (
+	"bytes"
+	"encoding/binary"
+	"errors"
+	"os"
+	"testing"
+	"time"
+
+	assert "github.com/Rafael24595/go-assert/assert/test"
+
+	"github.com/Rafael24595/go-reacterm-core/engine/terminal/capability"
+)
+
+func mapEnv(values map[string]string) func(string) string {
+	return func(key string) string {
+		return values[key]
+	}
+}
+
+func TestDetect_TrueColor(t *testing.T) {
+	caps := DetectFrom(mapEnv(map[string]string{
+		"TERM":      "xterm-256color",
+		"COLORTERM": "truecolor",
+		"LANG":      "en_US.UTF-8",
+		"TERMINFO":  "/nonexistent",
+	}))
+
+	assert.Equal(t, capability.ColorTrue, caps.Color)
+	assert.True(t, caps.Unicode)
+	assert.True(t, caps.Mouse)
+	assert.True(t, caps.AltScreen)
+}
+
+func TestDetect_NoColor(t *testing.T) {
+	caps := DetectFrom(mapEnv(map[string]string{
+		"TERM":     "xterm-256color",
+		"NO_COLOR": "1",
+	}))
+
+	assert.Equal(t, capability.ColorNone, caps.Color)
+}
+
+func TestDetect_LinuxConsole(t *testing.T) {
+	caps := DetectFrom(mapEnv(map[string]string{
+		"TERM": "linux",
+		"LANG": "en_US.UTF-8",
+	}))
+
+	assert.False(t, caps.Unicode)
+	assert.Equal(t, capability.Color16, caps.Color)
+}
+
+func TestDetect_Dumb(t *testing.T) {
+	caps := DetectFrom(mapEnv(map[string]string{
+		"TERM": "dumb",
+	}))
+
+	assert.Equal(t, capability.ColorNone, caps.Color)
+	assert.False(t, caps.Mouse)
+	assert.False(t, caps.AltScreen)
+}
+
+func TestDetect_AltScreenApartFromMouse(t *testing.T) {
+	caps := DetectFrom(mapEnv(map[string]string{
+		"TERM":     "screen-unlisted",
+		"TERMINFO": "/nonexistent",
+	}))
+
+	assert.True(t, caps.Mouse)
+	assert.False(t, caps.AltScreen)
+}
+
+func TestDetect_Hyperlinks(t *testing.T) {
+	tests := []struct {
+		name     string
+		env      map[string]string
+		expected bool
+	}{
+		{"kitty", map[string]string{"TERM": "xterm-kitty"}, true},
+		{"wezterm", map[string]string{"TERM": "wezterm"}, true},
+		{"program", map[string]string{"TERM": "xterm-256color", "TERM_PROGRAM": "iTerm.app"}, true},
+		{"vte", map[string]string{"TERM": "xterm-256color", "VTE_VERSION": "6003"}, true},
+		{"old vte", map[string]string{"TERM": "xterm-256color", "VTE_VERSION": "4200"}, false},
+		{"windows terminal", map[string]string{"TERM": "xterm-256color", "WT_SESSION": "id"}, true},
+		{"plain xterm", map[string]string{"TERM": "xterm-256color"}, false},
+		{"linux", map[string]string{"TERM": "linux", "WT_SESSION": "id"}, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			caps := DetectFrom(mapEnv(tt.env))
+			assert.Equal(t, tt.expected, caps.Hyperlinks)
+		})
+	}
+}
+
+func makeTerminfo(colors int16, altScreen, mouse bool) []byte {
+	names := []byte("test\x00")
+	numbers := numberColors + 1
+	strs := stringKeyMouse + 1
+
+	data := make([]byte, 0)
+	for _, v := range []int16{terminfoMagic16, int16(len(names)), 0, int16(numbers), int16(strs), 2} {
+		data = binary.LittleEndian.AppendUint16(data, uint16(v))
+	}
+
+	data = append(data, names...)
+	if len(data)%2 != 0 {
+		data = append(data, 0)
+	}
+
+	for i := 0; i < numbers; i++ {
+		value := int16(terminfoAbsent)
+		if i == numberColors {
+			value = colors
+		}
+		data = binary.LittleEndian.AppendUint16(data, uint16(value))
+	}
+
+	for i := 0; i < strs; i++ {
+		value := int16(terminfoAbsent)
+		if (i == stringEnterCa && altScreen) || (i == stringKeyMouse && mouse) {
+			value = 0
+		}
+		data = binary.LittleEndian.AppendUint16(data, uint16(value))
+	}
+
+	return append(data, 'x', 0)
+}
+
+func TestParseTerminfo(t *testing.T) {
+	info, err := ParseTerminfo(makeTerminfo(256, true, false))
+
+	assert.Nil(t, err)
+	assert.Equal(t, 256, info.Colors)
+	assert.True(t, info.AltScreen)
+	assert.False(t, info.Mouse)
+}
+
+func TestParseTerminfo_Invalid(t *testing.T) {
+	_, err := ParseTerminfo([]byte{0, 1, 2})
+
+	assert.NotNil(t, err)
+}
+
+func TestColorFromTerminfo(t *testing.T) {
+	assert.Equal(t, capability.ColorNone, ColorFromTerminfo(-1))
+	assert.Equal(t, capability.Color16, ColorFromTerminfo(8))
+	assert.Equal(t, capability.Color256, ColorFromTerminfo(256))
+	assert.Equal(t, capability.ColorTrue, ColorFromTerminfo(1<<24))
+}
+
+func TestParseResponse(t *testing.T) {
+	response, ok := ParseResponse("\x1bP>|XTerm(390)\x1b\\\x1b[?64;1;22c")
+
+	assert.True(t, ok)
+	assert.Equal(t, "XTerm(390)", response.Version)
+	assert.True(t, response.Has(AttributeColor))
+
+	_, ok = ParseResponse("\x1bP>|XTerm(390)\x1b\\")
+	assert.False(t, ok)
+}
+
+func TestResponse_ApplyColor(t *testing.T) {
+	response, _ := ParseResponse("\x1b[?64;1;22c")
+	caps := capability.Basic()
+
+	applied := response.ApplyFrom(caps, mapEnv(map[string]string{}))
+	assert.Equal(t, capability.Color16, applied.Color)
+
+	applied = response.ApplyFrom(caps, mapEnv(map[string]string{"NO_COLOR": "1"}))
+	assert.Equal(t, capability.ColorNone, applied.Color)
+
+	caps.Color = capability.ColorTrue
+	applied = response.ApplyFrom(caps, mapEnv(map[string]string{}))
+	assert.Equal(t, capability.ColorTrue, applied.Color)
+}
+
+func TestQuery_Answered(t *testing.T) {
+	in, terminal, err := os.Pipe()
+	assert.Nil(t, err)
+	defer in.Close()
+	defer terminal.Close()
+
+	var out bytes.Buffer
+	go terminal.WriteString("\x1bP>|XTerm(390)\x1b\\\x1b[?64;22c")
+
+	response, err := Query(in, &out, time.Second)
+
+	assert.Nil(t, err)
+	assert.Equal(t, "XTerm(390)", response.Version)
+	assert.Equal(t, queryVersion+queryAttributes, out.String())
+}
+
+func TestQuery_Timeout(t *testing.T) {
+	in, terminal, err := os.Pipe()
+	assert.Nil(t, err)
+	defer in.Close()
+	defer terminal.Close()
+
+	var out bytes.Buffer
+	_, err = Query(in, &out, 10*time.Millisecond)
+
+	assert.True(t, errors.Is(err, os.ErrDeadlineExceeded))
+}
+
+func TestQuery_NoDeadline(t *testing.T) {
+	in, err := os.CreateTemp(t.TempDir(), "stdin")
+	assert.Nil(t, err)
+	defer in.Close()
+
+	var out bytes.Buffer
+	_, err = Query(in, &out, time.Second)
+
+	assert.True(t, errors.Is(err, os.ErrNoDeadline))
+	assert.Equal(t, "", out.String())
+}
+
+func TestDetect_ExtendedKeys(t *testing.T) {
+	caps := DetectFrom(mapEnv(map[string]string{
+		"TERM":     "xterm-kitty",
+		"TERMINFO": "/nonexistent",
+	}))
+	assert.True(t, caps.ExtendedKeys)
+
+	caps = DetectFrom(mapEnv(map[string]string{
+		"TERM":     "linux",
+		"TERMINFO": "/nonexistent",
+	}))
+	assert.False(t, caps.ExtendedKeys)
+}
@@ -0,0 +1,120 @@
+package wrapper_probe
+
+import (
+	"io"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/Rafael24595/go-reacterm-core/engine/terminal/capability"
+)
+
+const (
+	queryVersion    = "\x1b[>0q"
+	queryAttributes = "\x1b[c"
+)
+
+const (
+	versionPrefix    = "\x1bP>|"
+	versionSuffix    = "\x1b\\"
+	attributesPrefix = "\x1b[?"
+	attributesSuffix = "c"
+)
+
+const AttributeColor = "22"
+
+type Response struct {
+	Attributes []string
+	Version    string
+}
+
+// Query asks the terminal for its version and attributes. The attributes
+// go last since every terminal answers them, which ends the wait early
+// on those that ignore the version query. in must support deadlines, or
+// nothing is sent so the answer never reaches the key reader.
+func Query(in *os.File, out io.Writer, timeout time.Duration) (Response, error) {
+	err := in.SetReadDeadline(time.Now().Add(timeout))
+	if err != nil {
+		return Response{}, err
+	}
+	defer in.SetReadDeadline(time.Time{})
+
+	_, err = io.WriteString(out, queryVersion+queryAttributes)
+	if err != nil {
+		return Response{}, err
+	}
+
+	var buffer strings.Builder
+	chunk := make([]byte, 64)
+
+	for {
+		n, err := in.Read(chunk)
+		buffer.Write(chunk[:n])
+
+		if response, ok := ParseResponse(buffer.String()); ok {
+			return response, nil
+		}
+
+		if err != nil {
+			response, _ := ParseResponse(buffer.String())
+			return response, err
+		}
+	}
+}
+
+func ParseResponse(raw string) (Response, bool) {
+	response := Response{
+		Attributes: make([]string, 0),
+		Version:    "",
+	}
+
+	if start := strings.Index(raw, versionPrefix); start >= 0 {
+		rest := raw[start+len(versionPrefix):]
+		if end := strings.Index(rest, versionSuffix); end >= 0 {
+			response.Version = rest[:end]
+		}
+	}
+
+	start := strings.Index(raw, attributesPrefix)
+	if start < 0 {
+		return response, false
+	}
+
+	rest := raw[start+len(attributesPrefix):]
+	end := strings.Index(rest, attributesSuffix)
+	if end < 0 {
+		return response, false
+	}
+
+	response.Attributes = strings.Split(rest[:end], ";")
+
+	return response, true
+}
+
+func (r Response) Apply(caps capability.Capabilities) capability.Capabilities {
+	return r.ApplyFrom(caps, os.Getenv)
+}
+
+// ApplyFrom raises the capabilities with the answer of the terminal.
+// A terminal reporting ANSI color gets at least 16 colors, unless the
+// user opted out with NO_COLOR.
+func (r Response) ApplyFrom(caps capability.Capabilities, env func(string) string) capability.Capabilities {
+	if r.Version != "" {
+		caps.Version = r.Version
+	}
+
+	if r.Has(AttributeColor) && env("NO_COLOR") == "" {
+		caps.Color = max(caps.Color, capability.Color16)
+	}
+
+	return caps
+}
+
+func (r Response) Has(attribute string) bool {
+	for _, a := range r.Attributes {
+		if a == attribute {
+			return true
+		}
+	}
+	return false
+}
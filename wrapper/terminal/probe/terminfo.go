@@ -0,0 +1,145 @@
+package wrapper_probe
+
+import (
+	"encoding/binary"
+	"errors"
+	"os"
+	"path/filepath"
+	"strconv"
+)
+
+const (
+	terminfoMagic16 = 0o432
+	terminfoMagic32 = 0o1036
+	terminfoHeader  = 12
+)
+
+const (
+	numberColors    = 13
+	stringEnterCa   = 28
+	stringKeyMouse  = 355
+	terminfoAbsent  = -1
+	terminfoDefault = "/usr/share/terminfo"
+)
+
+var errTerminfoFormat = errors.New("invalid terminfo format")
+
+type Terminfo struct {
+	Colors    int
+	AltScreen bool
+	Mouse     bool
+}
+
+func LoadTerminfo(env func(string) string, term string) (*Terminfo, error) {
+	if term == "" {
+		return nil, os.ErrNotExist
+	}
+
+	for _, dir := range terminfoDirs(env) {
+		for _, path := range terminfoPaths(dir, term) {
+			data, err := os.ReadFile(path)
+			if err != nil {
+				continue
+			}
+			return ParseTerminfo(data)
+		}
+	}
+
+	return nil, os.ErrNotExist
+}
+
+func ParseTerminfo(data []byte) (*Terminfo, error) {
+	if len(data) < terminfoHeader {
+		return nil, errTerminfoFormat
+	}
+
+	header := make([]int, 6)
+	for i := range header {
+		header[i] = int(int16(binary.LittleEndian.Uint16(data[i*2:])))
+	}
+
+	magic, names, bools, numbers, strs, table := header[0], header[1], header[2], header[3], header[4], header[5]
+
+	width := 2
+	switch magic {
+	case terminfoMagic16:
+	case terminfoMagic32:
+		width = 4
+	default:
+		return nil, errTerminfoFormat
+	}
+
+	offset := terminfoHeader + names + bools
+	if offset%2 != 0 {
+		offset++
+	}
+
+	numbersStart := offset
+	stringsStart := numbersStart + numbers*width
+	tableStart := stringsStart + strs*2
+
+	if tableStart+table > len(data) {
+		return nil, errTerminfoFormat
+	}
+
+	info := &Terminfo{
+		Colors:    terminfoAbsent,
+		AltScreen: false,
+		Mouse:     false,
+	}
+
+	if numberColors < numbers {
+		at := numbersStart + numberColors*width
+		if width == 2 {
+			info.Colors = int(int16(binary.LittleEndian.Uint16(data[at:])))
+		} else {
+			info.Colors = int(int32(binary.LittleEndian.Uint32(data[at:])))
+		}
+	}
+
+	info.AltScreen = hasString(data, stringsStart, strs, stringEnterCa)
+	info.Mouse = hasString(data, stringsStart, strs, stringKeyMouse)
+
+	return info, nil
+}
+
+func hasString(data []byte, start, count, index int) bool {
+	if index >= count {
+		return false
+	}
+
+	at := start + index*2
+	return int16(binary.LittleEndian.Uint16(data[at:])) >= 0
+}
+
+func terminfoDirs(env func(string) string) []string {
+	dirs := make([]string, 0)
+
+	if dir := env("TERMINFO"); dir != "" {
+		dirs = append(dirs, dir)
+	}
+
+	if home := env("HOME"); home != "" {
+		dirs = append(dirs, filepath.Join(home, ".terminfo"))
+	}
+
+	for _, dir := range filepath.SplitList(env("TERMINFO_DIRS")) {
+		if dir == "" {
+			dir = terminfoDefault
+		}
+		dirs = append(dirs, dir)
+	}
+
+	return append(dirs,
+		"/etc/terminfo",
+		"/lib/terminfo",
+		terminfoDefault,
+	)
+}
+
+func terminfoPaths(dir, term string) []string {
+	return []string{
+		filepath.Join(dir, term[:1], term),
+		filepath.Join(dir, strconv.FormatInt(int64(term[0]), 16), term),
+	}
+}
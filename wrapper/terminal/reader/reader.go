@@ -3,54 +3,151 @@ package wrapper_reader
 import (
 	"bufio"
 	"os"
+	"strconv"
 	"strings"
 	"time"
 	"unicode"
 
 	"github.com/Rafael24595/go-reacterm-core/engine/model/ascii"
 	"github.com/Rafael24595/go-reacterm-core/engine/model/key"
+	"github.com/Rafael24595/go-reacterm-core/engine/model/keymap"
+	"github.com/Rafael24595/go-reacterm-core/engine/model/mouse"
+	"github.com/Rafael24595/go-reacterm-core/engine/model/winsize"
 )
 
 const (
-	ansiModShift     = "2"
-	ansiModAlt       = "3"
-	ansiModCtrl      = "5"
-	ansiModCtrlShift = "6"
+	ansiModShift = 1
+	ansiModAlt   = 2
+	ansiModCtrl  = 4
+	ansiModMeta  = 8
 )
 
+const (
+	csiIntroducer   = '['
+	ss3Introducer   = 'O'
+	linuxIntroducer = '['
+)
+
+const (
+	rxvtShift     = '$'
+	rxvtCtrl      = '^'
+	rxvtCtrlShift = '@'
+)
+
+const (
+	sgrMouseMarker  = '<'
+	sgrMousePress   = 'M'
+	sgrMouseRelease = 'm'
+)
+
+const (
+	sgrButtonMask = 0b11
+	sgrModShift   = 4
+	sgrModAlt     = 8
+	sgrModCtrl    = 16
+	sgrMotion     = 32
+	sgrWheel      = 64
+)
+
+const (
+	csiUnicode      = 'u'
+	modifyOtherKeys = "27"
+)
+
+const (
+	pasteStart = "200"
+	pasteEnd   = "\x1b[201~"
+)
+
+type InputKind uint8
+
+const (
+	InputKey InputKind = iota
+	InputMouse
+	InputPaste
+)
+
+type Input struct {
+	Kind  InputKind
+	Key   key.Key
+	Mouse mouse.Mouse
+	Paste string
+}
+
+func inputFromKey(ky *key.Key) *Input {
+	return &Input{
+		Kind: InputKey,
+		Key:  *ky,
+	}
+}
+
+func inputFromMouse(ms *mouse.Mouse) *Input {
+	return &Input{
+		Kind:  InputMouse,
+		Mouse: *ms,
+	}
+}
+
+func inputFromPaste(text string) *Input {
+	return &Input{
+		Kind:  InputPaste,
+		Paste: text,
+	}
+}
+
 type KeyReader struct {
 	reader *bufio.Reader
+	keymap *keymap.Keymap
 }
 
 func New() *KeyReader {
 	return &KeyReader{
 		reader: bufio.NewReader(os.Stdin),
+		keymap: keymap.Default(),
 	}
 }
 
+func (r *KeyReader) Keymap(keymap *keymap.Keymap) *KeyReader {
+	r.keymap = keymap
+	return r
+}
+
 func (r *KeyReader) ReadKey() (*key.Key, error) {
+	for {
+		input, err := r.ReadInput()
+		if err != nil {
+			return key.NewKeySpace(), err
+		}
+
+		if input.Kind == InputKey {
+			return &input.Key, nil
+		}
+	}
+}
+
+func (r *KeyReader) ReadInput() (*Input, error) {
 	rn, _, err := r.reader.ReadRune()
 	if err != nil {
-		return key.NewKeySpace(), err
+		return inputFromKey(key.NewKeySpace()), err
 	}
 
-	if ky, ok := key.ControlKeyMap[rn]; ok {
-		return ky, nil
+	if isControlChar(rn) && rn != ascii.ESC {
+		return inputFromKey(r.resolveControlKey(rn)), nil
 	}
 
 	if rn != ascii.ESC {
 		sntz, _ := sanitizeRune(rn)
-		return key.NewKeyRune(sntz), nil
+		return inputFromKey(key.NewKeyRune(sntz)), nil
 	}
 
 	return r.processEscapeSequence()
 }
 
-func (r *KeyReader) processEscapeSequence() (*key.Key, error) {
+func (r *KeyReader) processEscapeSequence() (*Input, error) {
 	r.waitForMoreInput()
 
 	if r.reader.Buffered() == 0 {
-		return key.NewKeyCode(key.ActionEsc), nil
+		return inputFromKey(key.NewKeyCode(key.ActionEsc)), nil
 	}
 
 	rn, _, err := r.reader.ReadRune()
@@ -58,14 +155,18 @@ func (r *KeyReader) processEscapeSequence() (*key.Key, error) {
 		return nil, err
 	}
 
-	if rn == '[' {
+	if rn == csiIntroducer {
 		return r.readCSISequence()
 	}
 
-	return r.resolveAltKey(rn), nil
+	if rn == ss3Introducer && r.reader.Buffered() > 0 {
+		return r.readSS3Sequence()
+	}
+
+	return inputFromKey(r.resolveAltKey(rn)), nil
 }
 
-func (r *KeyReader) readCSISequence() (*key.Key, error) {
+func (r *KeyReader) readCSISequence() (*Input, error) {
 	var params strings.Builder
 	for {
 		rn, _, err := r.reader.ReadRune()
@@ -73,8 +174,93 @@ func (r *KeyReader) readCSISequence() (*key.Key, error) {
 			return nil, err
 		}
 
+		if params.Len() == 0 && rn == sgrMouseMarker {
+			return r.readSGRMouseSequence()
+		}
+
+		if params.Len() == 0 && rn == linuxIntroducer {
+			return r.readLinuxSequence()
+		}
+
+		if rn == ascii.TILDE && params.String() == pasteStart {
+			return r.readPasteSequence()
+		}
+
 		if isCSITerminator(rn) {
-			return r.parseCSI(params.String(), rn), nil
+			return inputFromKey(r.parseCSI(params.String(), rn)), nil
+		}
+
+		params.WriteRune(rn)
+	}
+}
+
+func (r *KeyReader) readSS3Sequence() (*Input, error) {
+	var params strings.Builder
+	for {
+		rn, _, err := r.reader.ReadRune()
+		if err != nil {
+			return nil, err
+		}
+
+		if unicode.IsDigit(rn) {
+			params.WriteRune(rn)
+			continue
+		}
+
+		return inputFromKey(r.parseSS3(params.String(), rn)), nil
+	}
+}
+
+func (r *KeyReader) readLinuxSequence() (*Input, error) {
+	rn, _, err := r.reader.ReadRune()
+	if err != nil {
+		return nil, err
+	}
+
+	if ky, ok := key.LinuxFunctionMap[rn]; ok {
+		return inputFromKey(r.resolveNamedKey(ky, key.ModNone)), nil
+	}
+
+	sntz, _ := sanitizeRune(rn)
+	return inputFromKey(key.NewKeyRune(sntz)), nil
+}
+
+func (r *KeyReader) readPasteSequence() (*Input, error) {
+	var text strings.Builder
+	for {
+		rn, _, err := r.reader.ReadRune()
+		if err != nil {
+			return nil, err
+		}
+
+		text.WriteRune(rn)
+
+		if rn == ascii.TILDE && strings.HasSuffix(text.String(), pasteEnd) {
+			paste := strings.TrimSuffix(text.String(), pasteEnd)
+			return inputFromPaste(normalizePaste(paste)), nil
+		}
+	}
+}
+
+func normalizePaste(text string) string {
+	text = strings.ReplaceAll(text, "\r\n", "\n")
+	return strings.ReplaceAll(text, "\r", "\n")
+}
+
+func (r *KeyReader) readSGRMouseSequence() (*Input, error) {
+	var params strings.Builder
+	for {
+		rn, _, err := r.reader.ReadRune()
+		if err != nil {
+			return nil, err
+		}
+
+		if rn == sgrMousePress || rn == sgrMouseRelease {
+			ms, ok := parseSGRMouse(params.String(), rn == sgrMouseRelease)
+			if !ok {
+				return inputFromKey(key.NewKeyRune(0)), nil
+			}
+			return inputFromMouse(ms), nil
 		}
 
 		params.WriteRune(rn)
@@ -82,42 +268,84 @@ func (r *KeyReader) readCSISequence() (*key.Key, error) {
 }
 
 func (r *KeyReader) parseCSI(params string, rn rune) *key.Key {
-	mod := r.parseModifier(params)
+	code, mod := r.splitParams(params)
+
+	switch rn {
+	case csiUnicode:
+		return r.resolveUnicodeCSI(code, mod)
+	case ascii.TILDE:
+		if code == modifyOtherKeys {
+			return r.resolveModifyOtherKeys(params)
+		}
+		return r.resolveTildeCSI(code, rn, mod)
+	case rxvtShift:
+		return r.resolveTildeCSI(code, rn, mod|key.ModShift)
+	case rxvtCtrl:
+		return r.resolveTildeCSI(code, rn, mod|key.ModCtrl)
+	case rxvtCtrlShift:
+		return r.resolveTildeCSI(code, rn, mod|key.ModCtrl|key.ModShift)
+	}
 
-	if rn == ascii.TILDE {
-		return r.resolveTildeCSI(params, rn, mod)
+	if ky, ok := key.RxvtArrowMap[rn]; ok {
+		return key.NewKeyCode(ky, mod|key.ModShift)
 	}
 
 	return r.resolveFinalCSI(rn, mod)
 }
 
-func (r *KeyReader) parseModifier(params string) key.ModMask {
-	if !strings.Contains(params, ";") {
-		return key.ModNone
+func (r *KeyReader) parseSS3(params string, rn rune) *key.Key {
+	mod := r.parseModifier(params)
+
+	if ky, ok := key.RxvtArrowMap[rn]; ok {
+		return key.NewKeyCode(ky, mod|key.ModCtrl)
 	}
 
+	if ky, ok := key.Ss3Map[rn]; ok {
+		return r.resolveNamedKey(ky, mod)
+	}
+
+	sntz, _ := sanitizeRune(rn)
+	return key.NewKeyRune(sntz)
+}
+
+func (r *KeyReader) splitParams(params string) (string, key.ModMask) {
 	parts := strings.Split(params, ";")
 	if len(parts) < 2 {
+		return parts[0], key.ModNone
+	}
+
+	return parts[0], r.parseModifier(parts[1])
+}
+
+func (r *KeyReader) parseModifier(param string) key.ModMask {
+	value, err := strconv.Atoi(param)
+	if err != nil || value < 2 {
 		return key.ModNone
 	}
 
-	switch parts[1] {
-	case ansiModShift:
-		return key.ModShift
-	case ansiModAlt:
-		return key.ModAlt
-	case ansiModCtrl:
-		return key.ModCtrl
-	case ansiModCtrlShift:
-		return key.ModShift | key.ModCtrl
+	bits := value - 1
+
+	mod := key.ModNone
+	if bits&ansiModShift != 0 {
+		mod |= key.ModShift
+	}
+	if bits&(ansiModAlt|ansiModMeta) != 0 {
+		mod |= key.ModAlt
+	}
+	if bits&ansiModCtrl != 0 {
+		mod |= key.ModCtrl
 	}
 
-	return key.ModNone
+	return mod
 }
 
-func (r *KeyReader) resolveTildeCSI(params string, rn rune, mod key.ModMask) *key.Key {
-	if ky, ok := key.CsiTildeMap[params]; ok {
-		return key.NewKeyCode(ky, mod)
+func (r *KeyReader) resolveTildeCSI(code string, rn rune, mod key.ModMask) *key.Key {
+	if ky, ok := key.CsiTildeMap[code]; ok {
+		return r.resolveNamedKey(ky, mod)
+	}
+
+	if ky, ok := key.CsiShiftTildeMap[code]; ok {
+		return r.resolveNamedKey(ky, mod|key.ModShift)
 	}
 
 	sntz, _ := sanitizeRune(rn)
@@ -126,7 +354,7 @@ func (r *KeyReader) resolveTildeCSI(params string, rn rune, mod key.ModMask) *ke
 
 func (r *KeyReader) resolveFinalCSI(rn rune, mod key.ModMask) *key.Key {
 	if ky, ok := key.CsiFinalMap[rn]; ok {
-		return key.NewKeyCode(ky, mod)
+		return r.resolveNamedKey(ky, mod)
 	}
 
 	sntz, _ := sanitizeRune(rn)
@@ -134,15 +362,159 @@ func (r *KeyReader) resolveFinalCSI(rn rune, mod key.ModMask) *key.Key {
 }
 
 func (r *KeyReader) resolveAltKey(rn rune) *key.Key {
-	exists, ok := key.AltKeyMap[rn]
-	if ok {
-		return exists
+	if isControlChar(rn) {
+		chord, ok := keymap.ControlChord(rn)
+		if ok {
+			chord.Mod |= key.ModAlt
+			if ky, ok := r.keymap.Resolve(chord); ok {
+				return ky
+			}
+		}
+	} else if ky, ok := r.keymap.Resolve(keymap.RuneChord(rn, key.ModAlt)); ok {
+		return ky
+	}
+
+	sntz, _ := sanitizeRune(rn)
+	return key.NewKeyRune(sntz)
+}
+
+func (r *KeyReader) resolveControlKey(rn rune) *key.Key {
+	chord, ok := keymap.ControlChord(rn)
+	if !ok {
+		return key.NewKeyRune(0)
+	}
+
+	if ky, ok := r.keymap.Resolve(chord); ok {
+		return ky
+	}
+
+	if chord.Code != key.ActionRune {
+		return key.NewKeyCode(chord.Code)
+	}
+
+	return key.NewKeyRune(0)
+}
+
+func (r *KeyReader) resolveNamedKey(code key.Action, mod key.ModMask) *key.Key {
+	if ky, ok := r.keymap.Resolve(keymap.NamedChord(code, mod)); ok {
+		return ky
+	}
+	return key.NewKeyCode(code, mod)
+}
+
+func (r *KeyReader) resolveRuneKey(rn rune, mod key.ModMask) *key.Key {
+	if chord, ok := keymap.ControlChord(rn); ok && chord.Code != key.ActionRune {
+		return r.resolveNamedKey(chord.Code, mod)
+	}
+
+	if rn == ascii.ESC {
+		return r.resolveNamedKey(key.ActionEsc, mod)
+	}
+
+	if ky, ok := r.keymap.Resolve(keymap.RuneChord(rn, mod)); ok {
+		return ky
 	}
 
 	sntz, _ := sanitizeRune(rn)
 	return key.NewKeyRune(sntz)
 }
 
+func (r *KeyReader) resolveUnicodeCSI(code string, mod key.ModMask) *key.Key {
+	value, err := strconv.Atoi(code)
+	if err != nil {
+		return key.NewKeyRune(0)
+	}
+	return r.resolveRuneKey(rune(value), mod)
+}
+
+func (r *KeyReader) resolveModifyOtherKeys(params string) *key.Key {
+	parts := strings.Split(params, ";")
+	if len(parts) != 3 {
+		return key.NewKeyRune(0)
+	}
+
+	value, err := strconv.Atoi(parts[2])
+	if err != nil {
+		return key.NewKeyRune(0)
+	}
+
+	return r.resolveRuneKey(rune(value), r.parseModifier(parts[1]))
+}
+
+func parseSGRMouse(params string, release bool) (*mouse.Mouse, bool) {
+	parts := strings.Split(params, ";")
+	if len(parts) != 3 {
+		return nil, false
+	}
+
+	values := make([]int, len(parts))
+	for i, p := range parts {
+		value, err := strconv.Atoi(p)
+		if err != nil || value < 0 {
+			return nil, false
+		}
+		values[i] = value
+	}
+
+	code, col, row := values[0], values[1], values[2]
+
+	mod := key.ModNone
+	if code&sgrModShift != 0 {
+		mod |= key.ModShift
+	}
+	if code&sgrModAlt != 0 {
+		mod |= key.ModAlt
+	}
+	if code&sgrModCtrl != 0 {
+		mod |= key.ModCtrl
+	}
+
+	kind, button := resolveSGRButton(code, release)
+
+	return mouse.New(
+		kind,
+		button,
+		winsize.Rows(max(0, row-1)),
+		winsize.Cols(max(0, col-1)),
+		mod,
+	), true
+}
+
+func resolveSGRButton(code int, release bool) (mouse.Kind, mouse.Button) {
+	if code&sgrWheel != 0 {
+		switch code & sgrButtonMask {
+		case 0:
+			return mouse.KindWheel, mouse.ButtonWheelUp
+		case 1:
+			return mouse.KindWheel, mouse.ButtonWheelDown
+		case 2:
+			return mouse.KindWheel, mouse.ButtonWheelLeft
+		default:
+			return mouse.KindWheel, mouse.ButtonWheelRight
+		}
+	}
+
+	button := mouse.ButtonNone
+	switch code & sgrButtonMask {
+	case 0:
+		button = mouse.ButtonLeft
+	case 1:
+		button = mouse.ButtonMiddle
+	case 2:
+		button = mouse.ButtonRight
+	}
+
+	if release {
+		return mouse.KindRelease, button
+	}
+
+	if code&sgrMotion != 0 {
+		return mouse.KindDrag, button
+	}
+
+	return mouse.KindPress, button
+}
+
 func (r *KeyReader) waitForMoreInput() {
 	if r.reader.Buffered() == 0 {
 		time.Sleep(1 * time.Millisecond)
@@ -150,7 +522,11 @@ func (r *KeyReader) waitForMoreInput() {
 }
 
 func isCSITerminator(rn rune) bool {
-	return (rn >= 'A' && rn <= 'Z') || rn == ascii.TILDE
+	switch rn {
+	case ascii.TILDE, rxvtShift, rxvtCtrl, rxvtCtrlShift:
+		return true
+	}
+	return (rn >= 'A' && rn <= 'Z') || (rn >= 'a' && rn <= 'z')
 }
 
 func isControlChar(rn rune) bool {
@@ -0,0 +1,177 @@
+package wrapper_reader
+
+import (
+	"bufio"
+	"strings"
+	"testing"
+
+	assert "github.com/Rafael24595/go-assert/assert/test"
+
+	"github.com/Rafael24595/go-reacterm-core/engine/model/key"
+	"github.com/Rafael24595/go-reacterm-core/engine/model/keymap"
+	"github.com/Rafael24595/go-reacterm-core/engine/model/mouse"
+)
+
+func newTestReader(input string) *KeyReader {
+	return &KeyReader{
+		reader: bufio.NewReader(strings.NewReader(input)),
+		keymap: keymap.Default(),
+	}
+}
+
+func TestReadInput_SGRMouse(t *testing.T) {
+	tests := []struct {
+		name   string
+		input  string
+		kind   mouse.Kind
+		button mouse.Button
+		mod    key.ModMask
+		row    int
+		col    int
+	}{
+		{"left press", "\x1b[<0;10;5M", mouse.KindPress, mouse.ButtonLeft, key.ModNone, 4, 9},
+		{"right release", "\x1b[<2;1;1m", mouse.KindRelease, mouse.ButtonRight, key.ModNone, 0, 0},
+		{"left drag", "\x1b[<32;3;2M", mouse.KindDrag, mouse.ButtonLeft, key.ModNone, 1, 2},
+		{"wheel up", "\x1b[<64;1;1M", mouse.KindWheel, mouse.ButtonWheelUp, key.ModNone, 0, 0},
+		{"wheel down", "\x1b[<65;1;1M", mouse.KindWheel, mouse.ButtonWheelDown, key.ModNone, 0, 0},
+		{"wheel left", "\x1b[<66;1;1M", mouse.KindWheel, mouse.ButtonWheelLeft, key.ModNone, 0, 0},
+		{"wheel right", "\x1b[<67;1;1M", mouse.KindWheel, mouse.ButtonWheelRight, key.ModNone, 0, 0},
+		{"ctrl click", "\x1b[<16;2;2M", mouse.KindPress, mouse.ButtonLeft, key.ModCtrl, 1, 1},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			input, err := newTestReader(tt.input).ReadInput()
+
+			assert.Nil(t, err)
+			assert.Equal(t, InputMouse, input.Kind)
+			assert.Equal(t, tt.kind, input.Mouse.Kind)
+			assert.Equal(t, tt.button, input.Mouse.Button)
+			assert.Equal(t, tt.mod, input.Mouse.Mod)
+			assert.Equal(t, tt.row, int(input.Mouse.Row))
+			assert.Equal(t, tt.col, int(input.Mouse.Col))
+		})
+	}
+}
+
+func TestReadKey_SkipsMouse(t *testing.T) {
+	ky, err := newTestReader("\x1b[<0;1;1Ma").ReadKey()
+
+	assert.Nil(t, err)
+	assert.Equal(t, 'a', ky.Rune)
+}
+
+func TestReadInput_CSIKey(t *testing.T) {
+	input, err := newTestReader("\x1b[A").ReadInput()
+
+	assert.Nil(t, err)
+	assert.Equal(t, InputKey, input.Kind)
+	assert.Equal(t, key.ActionArrowUp, input.Key.Code)
+}
+
+func TestReadInput_BracketedPaste(t *testing.T) {
+	reader := newTestReader("\x1b[200~one\r\ntwo\x1b[A\x1b[201~x")
+
+	input, err := reader.ReadInput()
+
+	assert.Nil(t, err)
+	assert.Equal(t, InputPaste, input.Kind)
+	assert.Equal(t, "one\ntwo\x1b[A", input.Paste)
+
+	input, err = reader.ReadInput()
+
+	assert.Nil(t, err)
+	assert.Equal(t, InputKey, input.Kind)
+	assert.Equal(t, 'x', input.Key.Rune)
+}
+
+func TestReadInput_FunctionKeys(t *testing.T) {
+	tests := []struct {
+		name   string
+		input  string
+		action key.Action
+		mod    key.ModMask
+	}{
+		{"ss3 f1", "\x1bOP", key.ActionF1, key.ModNone},
+		{"ss3 f4", "\x1bOS", key.ActionF4, key.ModNone},
+		{"ss3 arrow", "\x1bOA", key.ActionArrowUp, key.ModNone},
+		{"ss3 modified f2", "\x1bO2Q", key.ActionF2, key.ModShift},
+		{"xterm modified f1", "\x1b[1;5P", key.ActionF1, key.ModCtrl},
+		{"xterm f5", "\x1b[15~", key.ActionF5, key.ModNone},
+		{"xterm f12", "\x1b[24~", key.ActionF12, key.ModNone},
+		{"xterm modified f10", "\x1b[21;3~", key.ActionF10, key.ModAlt},
+		{"xterm ctrl shift delete", "\x1b[3;6~", key.ActionDelete, key.ModCtrl | key.ModShift},
+		{"vt220 f1", "\x1b[11~", key.ActionF1, key.ModNone},
+		{"linux f1", "\x1b[[A", key.ActionF1, key.ModNone},
+		{"linux f5", "\x1b[[E", key.ActionF5, key.ModNone},
+		{"insert", "\x1b[2~", key.ActionInsert, key.ModNone},
+		{"back tab", "\x1b[Z", key.ActionBackTab, key.ModNone},
+		{"rxvt shift f3", "\x1b[25~", key.ActionF3, key.ModShift},
+		{"rxvt ctrl f1", "\x1b[11^", key.ActionF1, key.ModCtrl},
+		{"rxvt shift insert", "\x1b[2$", key.ActionInsert, key.ModShift},
+		{"rxvt shift arrow", "\x1b[a", key.ActionArrowUp, key.ModShift},
+		{"rxvt ctrl arrow", "\x1bOd", key.ActionArrowLeft, key.ModCtrl},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			input, err := newTestReader(tt.input).ReadInput()
+
+			assert.Nil(t, err)
+			assert.Equal(t, InputKey, input.Kind)
+			assert.Equal(t, tt.action, input.Key.Code)
+			assert.Equal(t, tt.mod, input.Key.Mod)
+		})
+	}
+}
+
+func TestReadInput_AltO(t *testing.T) {
+	input, err := newTestReader("\x1bO").ReadInput()
+
+	assert.Nil(t, err)
+	assert.Equal(t, 'O', input.Key.Rune)
+}
+
+func TestReadInput_Keymap(t *testing.T) {
+	km, err := keymap.FromEntries(map[string][]string{
+		"copy": {"ctrl+shift+c", "ctrl+y"},
+		"help": {"f1"},
+	})
+	assert.Nil(t, err)
+
+	tests := []struct {
+		name   string
+		input  string
+		action key.Action
+	}{
+		{"ctrl letter", "\x19", key.CustomActionCopy},
+		{"named key", "\x1bOP", key.CustomActionHelp},
+		{"csi u", "\x1b[67;5u", key.CustomActionCopy},
+		{"modify other keys", "\x1b[27;6;99~", key.CustomActionCopy},
+		{"default kept", "\x01", key.ActionHome},
+		{"csi u default", "\x1b[97;5u", key.ActionHome},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			input, err := newTestReader(tt.input).Keymap(km).ReadInput()
+
+			assert.Nil(t, err)
+			assert.Equal(t, InputKey, input.Kind)
+			assert.Equal(t, tt.action, input.Key.Code)
+		})
+	}
+}
+
+func TestReadInput_KeymapReplacesDefault(t *testing.T) {
+	km, err := keymap.FromEntries(map[string][]string{
+		"copy": {"ctrl+k"},
+	})
+	assert.Nil(t, err)
+
+	input, err := newTestReader("\x1bc").Keymap(km).ReadInput()
+
+	assert.Nil(t, err)
+	assert.Equal(t, key.ActionRune, input.Key.Code)
+	assert.Equal(t, 'c', input.Key.Rune)
+}
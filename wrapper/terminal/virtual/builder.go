@@ -0,0 +1,57 @@
+package wrapper_virtual
+
+import (
+	"github.com/Rafael24595/go-reacterm-core/engine/model/key"
+	"github.com/Rafael24595/go-reacterm-core/engine/model/winsize"
+	"github.com/Rafael24595/go-reacterm-core/engine/terminal"
+)
+
+type VirtualBuilder struct {
+	size    winsize.Winsize
+	script  []key.Key
+	keys    <-chan key.Key
+	resizes <-chan winsize.Winsize
+	color   string
+}
+
+func NewBuilder(size winsize.Winsize) *VirtualBuilder {
+	return &VirtualBuilder{
+		size:    size,
+		script:  make([]key.Key, 0),
+		keys:    nil,
+		resizes: nil,
+		color:   "",
+	}
+}
+
+func (b *VirtualBuilder) Script(keys ...key.Key) *VirtualBuilder {
+	b.script = append(b.script, keys...)
+	return b
+}
+
+func (b *VirtualBuilder) Keys(keys <-chan key.Key) *VirtualBuilder {
+	b.keys = keys
+	return b
+}
+
+func (b *VirtualBuilder) Resizes(resizes <-chan winsize.Winsize) *VirtualBuilder {
+	b.resizes = resizes
+	return b
+}
+
+func (b *VirtualBuilder) Color(color string) *VirtualBuilder {
+	b.color = color
+	return b
+}
+
+func (b *VirtualBuilder) Build() *Virtual {
+	virtual := newVirtual(b.size, b.color)
+	virtual.script = b.script
+	virtual.keySource = b.keys
+	virtual.resizeSource = b.resizes
+	return virtual
+}
+
+func (b *VirtualBuilder) ToTerminal() terminal.Terminal {
+	return b.Build().ToTerminal()
+}
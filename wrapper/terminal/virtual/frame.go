@@ -0,0 +1,59 @@
+package wrapper_virtual
+
+import (
+	"strings"
+
+	"github.com/Rafael24595/go-reacterm-core/engine/model/winsize"
+
+	wrapper_grid "github.com/Rafael24595/go-reacterm-core/wrapper/terminal/grid"
+)
+
+type Frame struct {
+	Size  winsize.Winsize
+	Lines []string
+	Cells [][]wrapper_grid.Cell
+}
+
+func newFrame(lines []string, size winsize.Winsize, base wrapper_grid.Style) Frame {
+	plain := make([]string, size.Rows)
+	cells := make([][]wrapper_grid.Cell, size.Rows)
+
+	for i := range cells {
+		line := ""
+		if i < len(lines) {
+			line = lines[i]
+		}
+
+		cells[i] = wrapper_grid.ParseLine(line, size.Cols, base)
+		plain[i] = plainText(cells[i])
+	}
+
+	return Frame{
+		Size:  size,
+		Lines: plain,
+		Cells: cells,
+	}
+}
+
+func (f Frame) Text() string {
+	return strings.Join(f.Lines, "\n")
+}
+
+func (f Frame) Contains(text string) bool {
+	return strings.Contains(f.Text(), text)
+}
+
+func (f Frame) Cell(row winsize.Rows, col winsize.Cols) (wrapper_grid.Cell, bool) {
+	if int(row) >= len(f.Cells) || int(col) >= len(f.Cells[row]) {
+		return wrapper_grid.Cell{}, false
+	}
+	return f.Cells[row][col], true
+}
+
+func plainText(cells []wrapper_grid.Cell) string {
+	var sb strings.Builder
+	for _, c := range cells {
+		sb.WriteString(c.Text)
+	}
+	return strings.TrimRight(sb.String(), " ")
+}
@@ -0,0 +1,326 @@
+package wrapper_virtual
+
+import (
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/Rafael24595/go-reacterm-core/engine/model/key"
+	"github.com/Rafael24595/go-reacterm-core/engine/model/mouse"
+	"github.com/Rafael24595/go-reacterm-core/engine/model/winsize"
+	"github.com/Rafael24595/go-reacterm-core/engine/terminal"
+
+	wrapper_grid "github.com/Rafael24595/go-reacterm-core/wrapper/terminal/grid"
+)
+
+const frameBuffer = 64
+
+type Virtual struct {
+	mutex        sync.Mutex
+	size         winsize.Winsize
+	base         wrapper_grid.Style
+	lines        []string
+	frames       []Frame
+	frameChan    chan Frame
+	script       []key.Key
+	keySource    <-chan key.Key
+	resizeSource <-chan winsize.Winsize
+	keyChan      chan key.Key
+	mouseChan    chan mouse.Mouse
+	pasteChan    chan string
+	resizeChan   chan winsize.Winsize
+	resumeChan   chan winsize.Winsize
+	done         chan struct{}
+	closeOnce    sync.Once
+	started      bool
+	closed       bool
+	suspended    bool
+}
+
+func newVirtual(size winsize.Winsize, color string) *Virtual {
+	return &Virtual{
+		size:       size,
+		base:       wrapper_grid.ParseSequence(color),
+		lines:      make([]string, 0, size.Rows),
+		frames:     make([]Frame, 0),
+		frameChan:  make(chan Frame, frameBuffer),
+		script:     make([]key.Key, 0),
+		keyChan:    make(chan key.Key),
+		mouseChan:  make(chan mouse.Mouse),
+		pasteChan:  make(chan string),
+		resizeChan: make(chan winsize.Winsize, 1),
+		resumeChan: make(chan winsize.Winsize, 1),
+		done:       make(chan struct{}),
+	}
+}
+
+func (t *Virtual) ToTerminal() terminal.Terminal {
+	return terminal.Terminal{
+		OnStart:      t.OnStart,
+		OnClose:      t.OnClose,
+		ResizeEvents: t.ResizeEvents,
+		KeyEvents:    t.KeyEvents,
+		MouseEvents:  t.MouseEvents,
+		PasteEvents:  t.PasteEvents,
+		ResumeEvents: t.ResumeEvents,
+		Suspend:      t.Suspend,
+		Size:         t.Size,
+		Clear:        t.Clear,
+		Write:        t.Write,
+		WriteAll:     t.WriteAll,
+		Flush:        t.Flush,
+	}
+}
+
+func (t *Virtual) OnStart() error {
+	t.mutex.Lock()
+	defer t.mutex.Unlock()
+
+	t.started = true
+
+	go t.listenKeyEvents()
+	go t.listenResizeEvents()
+
+	return nil
+}
+
+func (t *Virtual) OnClose() error {
+	t.closeOnce.Do(func() {
+		t.mutex.Lock()
+		t.closed = true
+		t.mutex.Unlock()
+
+		close(t.done)
+	})
+	return nil
+}
+
+func (t *Virtual) Started() bool {
+	t.mutex.Lock()
+	defer t.mutex.Unlock()
+	return t.started
+}
+
+func (t *Virtual) Closed() bool {
+	t.mutex.Lock()
+	defer t.mutex.Unlock()
+	return t.closed
+}
+
+func (t *Virtual) Suspended() bool {
+	t.mutex.Lock()
+	defer t.mutex.Unlock()
+	return t.suspended
+}
+
+func (t *Virtual) Suspend() error {
+	t.mutex.Lock()
+	defer t.mutex.Unlock()
+	t.suspended = true
+	return nil
+}
+
+func (t *Virtual) Resume() {
+	t.mutex.Lock()
+	t.suspended = false
+	size := t.size
+	t.mutex.Unlock()
+
+	select {
+	case <-t.resumeChan:
+	default:
+	}
+
+	t.resumeChan <- size
+}
+
+func (t *Virtual) Done() <-chan struct{} {
+	return t.done
+}
+
+func (t *Virtual) ResizeEvents() <-chan winsize.Winsize {
+	return t.resizeChan
+}
+
+func (t *Virtual) ResumeEvents() <-chan winsize.Winsize {
+	return t.resumeChan
+}
+
+func (t *Virtual) KeyEvents() <-chan key.Key {
+	return t.keyChan
+}
+
+func (t *Virtual) MouseEvents() <-chan mouse.Mouse {
+	return t.mouseChan
+}
+
+func (t *Virtual) PasteEvents() <-chan string {
+	return t.pasteChan
+}
+
+func (t *Virtual) listenKeyEvents() {
+	for _, k := range t.script {
+		if !t.SendKey(k) {
+			return
+		}
+	}
+
+	if t.keySource == nil {
+		return
+	}
+
+	for {
+		select {
+		case <-t.done:
+			return
+		case k, ok := <-t.keySource:
+			if !ok {
+				return
+			}
+			if !t.SendKey(k) {
+				return
+			}
+		}
+	}
+}
+
+func (t *Virtual) listenResizeEvents() {
+	if t.resizeSource == nil {
+		return
+	}
+
+	for {
+		select {
+		case <-t.done:
+			return
+		case size, ok := <-t.resizeSource:
+			if !ok {
+				return
+			}
+			t.Resize(size)
+		}
+	}
+}
+
+func (t *Virtual) SendKey(k key.Key) bool {
+	select {
+	case <-t.done:
+		return false
+	case t.keyChan <- k:
+		return true
+	}
+}
+
+func (t *Virtual) SendMouse(m mouse.Mouse) bool {
+	select {
+	case <-t.done:
+		return false
+	case t.mouseChan <- m:
+		return true
+	}
+}
+
+func (t *Virtual) SendPaste(text string) bool {
+	select {
+	case <-t.done:
+		return false
+	case t.pasteChan <- text:
+		return true
+	}
+}
+
+func (t *Virtual) Resize(size winsize.Winsize) {
+	t.mutex.Lock()
+	t.size = size
+	t.mutex.Unlock()
+
+	select {
+	case <-t.resizeChan:
+	default:
+	}
+
+	t.resizeChan <- size
+}
+
+func (t *Virtual) Size() (winsize.Winsize, error) {
+	t.mutex.Lock()
+	defer t.mutex.Unlock()
+	return t.size, nil
+}
+
+func (t *Virtual) Clear() error {
+	return nil
+}
+
+func (t *Virtual) Write(lines ...string) error {
+	t.mutex.Lock()
+	defer t.mutex.Unlock()
+
+	t.lines = append(t.lines, lines...)
+	return nil
+}
+
+func (t *Virtual) WriteAll(text string) error {
+	return t.Write(strings.Split(text, "\n")...)
+}
+
+func (t *Virtual) Flush() error {
+	t.mutex.Lock()
+
+	frame := newFrame(t.lines, t.size, t.base)
+	t.frames = append(t.frames, frame)
+	t.lines = make([]string, 0, t.size.Rows)
+
+	t.mutex.Unlock()
+
+	select {
+	case t.frameChan <- frame:
+	default:
+	}
+
+	return nil
+}
+
+func (t *Virtual) Frames() []Frame {
+	t.mutex.Lock()
+	defer t.mutex.Unlock()
+
+	frames := make([]Frame, len(t.frames))
+	copy(frames, t.frames)
+
+	return frames
+}
+
+func (t *Virtual) Last() (Frame, bool) {
+	t.mutex.Lock()
+	defer t.mutex.Unlock()
+
+	if len(t.frames) == 0 {
+		return Frame{}, false
+	}
+
+	return t.frames[len(t.frames)-1], true
+}
+
+func (t *Virtual) Next(timeout time.Duration) (Frame, bool) {
+	select {
+	case frame := <-t.frameChan:
+		return frame, true
+	case <-time.After(timeout):
+		return Frame{}, false
+	}
+}
+
+func (t *Virtual) Await(timeout time.Duration, predicate func(Frame) bool) (Frame, bool) {
+	deadline := time.After(timeout)
+	for {
+		select {
+		case frame := <-t.frameChan:
+			if predicate(frame) {
+				return frame, true
+			}
+		case <-deadline:
+			return Frame{}, false
+		}
+	}
+}
@@ -0,0 +1,208 @@
+package wrapper_virtual
+
+import (
+	"context"
+	"strings"
+	"testing"
+	"time"
+	"unicode/utf8"
+
+	assert "github.com/Rafael24595/go-assert/assert/test"
+
+	"github.com/Rafael24595/go-reacterm-core/engine/app/cleaner/stack"
+	"github.com/Rafael24595/go-reacterm-core/engine/app/core"
+	"github.com/Rafael24595/go-reacterm-core/engine/app/screen"
+	"github.com/Rafael24595/go-reacterm-core/engine/app/screen/node/primitive/checkmenu"
+	"github.com/Rafael24595/go-reacterm-core/engine/app/screen/node/primitive/indexmenu"
+	"github.com/Rafael24595/go-reacterm-core/engine/layout"
+	"github.com/Rafael24595/go-reacterm-core/engine/layout/composer"
+	"github.com/Rafael24595/go-reacterm-core/engine/model/input"
+	"github.com/Rafael24595/go-reacterm-core/engine/model/key"
+	"github.com/Rafael24595/go-reacterm-core/engine/model/mouse"
+	"github.com/Rafael24595/go-reacterm-core/engine/model/winsize"
+	"github.com/Rafael24595/go-reacterm-core/engine/model/winsize/transformer"
+	"github.com/Rafael24595/go-reacterm-core/engine/render"
+	"github.com/Rafael24595/go-reacterm-core/engine/render/processor"
+	"github.com/Rafael24595/go-reacterm-core/engine/render/style"
+	"github.com/Rafael24595/go-reacterm-core/engine/render/styler"
+	"github.com/Rafael24595/go-reacterm-core/engine/render/text"
+
+	wrapper_render "github.com/Rafael24595/go-reacterm-core/wrapper/render"
+)
+
+const timeout = time.Second
+
+func runEngine(ctx context.Context, virtual *Virtual, node screen.Node) <-chan struct{} {
+	atom := styler.NewDefaultAtom().
+		Push(wrapper_render.Atoms.ToPairsSlice()...)
+
+	standard := processor.New(*atom, *styler.NewDefaultSpec())
+
+	margin := transformer.WithMargin(0, 0)
+
+	rnd := render.NewBuilder(processor.WithPadding(margin, standard.Render)).
+		ToRender()
+
+	lyt := layout.NewBuilder(composer.Standard).
+		Transformer(margin).
+		ToLayout()
+
+	return core.NewEngine(
+		virtual.ToTerminal(),
+		lyt,
+		rnd,
+		stack.NewCleaner(),
+		node,
+	).RunWithContext(ctx)
+}
+
+func makeMenu() screen.Node {
+	void := func() screen.Node { return screen.Node{} }
+	return indexmenu.New().
+		AddOptions(
+			input.NewMenuOption("a", *text.NewFragment("Alpha"), void),
+			input.NewMenuOption("b", *text.NewFragment("Beta"), void),
+		).
+		ToNode()
+}
+
+func TestVirtual_CapturesFrames(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	virtual := NewBuilder(winsize.New(10, 40)).Build()
+	done := runEngine(ctx, virtual, makeMenu())
+
+	frame, ok := virtual.Next(timeout)
+
+	assert.True(t, ok)
+	assert.True(t, virtual.Started())
+	assert.True(t, frame.Contains("Alpha"))
+	assert.True(t, frame.Contains("Beta"))
+	assert.Len(t, 10, frame.Lines)
+
+	cancel()
+	<-done
+
+	assert.True(t, virtual.Closed())
+}
+
+func TestVirtual_ScriptedKeys(t *testing.T) {
+	virtual := NewBuilder(winsize.New(10, 40)).
+		Script(
+			*key.NewKeyCode(key.ActionArrowDown),
+			*key.NewKeyCode(key.ActionExit),
+		).
+		Build()
+
+	done := runEngine(context.Background(), virtual, makeMenu())
+
+	select {
+	case <-done:
+	case <-time.After(timeout):
+		t.Fatal("engine did not exit")
+	}
+
+	assert.Len(t, 2, virtual.Frames())
+}
+
+func TestVirtual_Resize(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	virtual := NewBuilder(winsize.New(10, 40)).Build()
+	done := runEngine(ctx, virtual, makeMenu())
+
+	_, ok := virtual.Next(timeout)
+	assert.True(t, ok)
+
+	virtual.Resize(winsize.New(6, 30))
+
+	frame, ok := virtual.Await(timeout, func(f Frame) bool {
+		return f.Size.Eq(winsize.New(6, 30))
+	})
+
+	assert.True(t, ok)
+	assert.Len(t, 6, frame.Cells)
+	assert.Len(t, 30, frame.Cells[0])
+
+	cancel()
+	<-done
+}
+
+func TestVirtual_SuspendResume(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	virtual := NewBuilder(winsize.New(10, 40)).Build()
+	done := runEngine(ctx, virtual, makeMenu())
+
+	_, ok := virtual.Next(timeout)
+	assert.True(t, ok)
+
+	assert.True(t, virtual.SendKey(*key.NewKeyCode(key.ActionSuspend)))
+	assert.True(t, virtual.SendKey(*key.NewKeyCode(key.ActionArrowDown)))
+
+	_, ok = virtual.Next(timeout)
+	assert.True(t, ok)
+	assert.True(t, virtual.Suspended())
+
+	frames := len(virtual.Frames())
+	virtual.Resume()
+
+	frame, ok := virtual.Next(timeout)
+
+	assert.True(t, ok)
+	assert.False(t, virtual.Suspended())
+	assert.True(t, frame.Contains("Alpha"))
+	assert.Len(t, frames+1, virtual.Frames())
+
+	cancel()
+	<-done
+}
+
+func TestVirtual_ClickHorizontalCheckMenu(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	menu := checkmenu.New().
+		AddOptions(
+			input.NewCheckOption("a", *text.NewFragment("Alpha")),
+			input.NewCheckOption("b", *text.NewFragment("Beta")),
+		).
+		Distribution(style.Distribution{
+			Direction: style.Horizontal,
+			Justify:   style.JustifyStart,
+		}).
+		ToNode()
+
+	virtual := NewBuilder(winsize.New(10, 40)).Build()
+	done := runEngine(ctx, virtual, menu)
+
+	frame, ok := virtual.Next(timeout)
+	assert.True(t, ok)
+
+	row, col := -1, -1
+	for i, line := range frame.Lines {
+		if at := strings.Index(line, "Beta"); at >= 0 {
+			row, col = i, utf8.RuneCountInString(line[:at])
+			break
+		}
+	}
+	assert.True(t, row >= 0)
+
+	click := *mouse.New(mouse.KindPress, mouse.ButtonLeft, winsize.Rows(row), winsize.Cols(col))
+
+	assert.True(t, virtual.SendMouse(click))
+	assert.True(t, virtual.SendMouse(click))
+
+	frame, ok = virtual.Await(timeout, func(f Frame) bool {
+		return strings.Contains(f.Lines[row], "[x] Beta")
+	})
+
+	assert.True(t, ok)
+	assert.True(t, strings.Contains(frame.Lines[row], "[ ] Alpha"))
+
+	cancel()
+	<-done
+}